@@ -18,6 +18,8 @@ import (
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateencryption"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/exp/maps"
@@ -34,6 +36,7 @@ type AWSClient struct {
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	StateEncrypter          stateencryption.Encrypter
 	TerraformVersion        string
 
 	awsConfig                 *aws_sdkv2.Config
@@ -144,10 +147,7 @@ func (c *AWSClient) APIGatewayV2InvokeURL(protocolType, apiID, stageName string)
 // CloudFrontDistributionHostedZoneID returns the Route 53 hosted zone ID
 // for Amazon CloudFront distributions in the configured AWS partition.
 func (c *AWSClient) CloudFrontDistributionHostedZoneID() string {
-	if c.Partition == endpoints_sdkv1.AwsCnPartitionID {
-		return "Z3RFFRIM2A3IF5" // See https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html
-	}
-	return "Z2FDTNDATAQYW2" // See https://docs.aws.amazon.com/Route53/latest/APIReference/API_AliasTarget.html#Route53-Type-AliasTarget-HostedZoneId
+	return c.partitionHostedZoneID(staticdata.ServiceCloudFront)
 }
 
 // DefaultKMSKeyPolicy returns the default policy for KMS keys in the configured AWS partition.
@@ -174,7 +174,19 @@ func (c *AWSClient) DefaultKMSKeyPolicy() string {
 // GlobalAcceleratorHostedZoneID returns the Route 53 hosted zone ID
 // for AWS Global Accelerator accelerators in the configured AWS partition.
 func (c *AWSClient) GlobalAcceleratorHostedZoneID() string {
-	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
+	return c.partitionHostedZoneID(staticdata.ServiceGlobalAccelerator)
+}
+
+// partitionHostedZoneID returns the partition-wide Route 53 hosted zone ID for the specified service
+// in the configured AWS partition, falling back to the standard partition's hosted zone ID.
+func (c *AWSClient) partitionHostedZoneID(service string) string {
+	if v, err := staticdata.LookupForPartition(staticdata.KindHostedZoneID, service, c.Partition); err == nil {
+		return v
+	}
+
+	v, _ := staticdata.LookupForPartition(staticdata.KindHostedZoneID, service, endpoints_sdkv1.AwsPartitionID)
+
+	return v
 }
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
@@ -10,6 +10,7 @@ import (
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateencryption"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -52,12 +54,14 @@ type Config struct {
 	SkipCredsValidation            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
+	StateEncryptionConfig          *stateencryption.Config
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	UserAgent                      awsbase.UserAgentProducts
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -96,6 +100,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		Token:                         c.Token,
 		UseDualStackEndpoint:          c.UseDualStackEndpoint,
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
+		UserAgent:                     c.UserAgent,
 	}
 
 	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
@@ -152,6 +157,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
+	// Append the Terraform resource type and CRUD operation to the User-Agent of all API calls.
+	cfg.APIOptions = append(cfg.APIOptions, withUserAgentFromContext)
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	sess, awsDiags := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 
@@ -167,6 +175,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	sess.Handlers.Build.PushBackNamed(userAgentFromContextHandler)
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -214,6 +224,22 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3UsEast1RegionalEndpoint = c.S3UsEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
+	if c.StateEncryptionConfig != nil {
+		if v := c.StateEncryptionConfig.KMSKeyID; v != "" {
+			client.StateEncrypter = stateencryption.NewCachingEncrypter(stateencryption.NewKMSEncrypter(func(ctx context.Context) kmsiface.KMSAPI {
+				return client.KMSConn(ctx)
+			}, v))
+		} else {
+			encrypter, err := stateencryption.NewPGPEncrypter(c.StateEncryptionConfig.PGPKey, c.StateEncryptionConfig.PGPPrivateKey)
+
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "configuring state encryption: %s", err)
+			}
+
+			client.StateEncrypter = stateencryption.NewCachingEncrypter(encrypter)
+		}
+	}
+
 	return client, diags
 }
 
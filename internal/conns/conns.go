@@ -36,24 +36,28 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	Operation          string // CRUD operation, e.g. "create"
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -74,6 +78,7 @@ func NewSessionForRegion(cfg *aws_sdkv1.Config, region, terraformVersion string)
 	apnInfo := StdUserAgentProducts(terraformVersion)
 
 	awsbasev1.SetSessionUserAgent(session, apnInfo, awsbase.UserAgentProducts{})
+	session.Handlers.Build.PushBackNamed(userAgentFromContextHandler)
 
 	return session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)}), nil
 }
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// CRUD operations recorded in Context and reported in the User-Agent of AWS API calls.
+const (
+	OperationCreate = "create"
+	OperationDelete = "delete"
+	OperationImport = "import"
+	OperationRead   = "read"
+	OperationUpdate = "update"
+)
+
+// NewOperationContext returns a copy of Context with the resource information's CRUD operation set.
+func NewOperationContext(ctx context.Context, operation string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	inContext := *v
+	inContext.Operation = operation
+
+	return context.WithValue(ctx, contextKey, &inContext)
+}
+
+// userAgentProductsFromContext returns the User-Agent products describing the Terraform resource
+// or data source, and the CRUD operation, in Context.
+// e.g. "terraform-resource/aws_subnet (create)".
+func userAgentProductsFromContext(ctx context.Context) awsbase.UserAgentProducts {
+	inContext, ok := FromContext(ctx)
+	if !ok || inContext.TypeName == "" {
+		return nil
+	}
+
+	name := "terraform-resource"
+	if inContext.IsDataSource {
+		name = "terraform-data-source"
+	}
+
+	return awsbase.UserAgentProducts{
+		{Name: name, Version: inContext.TypeName, Comment: inContext.Operation},
+	}
+}
+
+// userAgentFromContextHandler is an AWS SDK for Go v1 request handler that appends
+// the resource information in Context to the User-Agent header.
+var userAgentFromContextHandler = request.NamedHandler{
+	Name: "tf.UserAgentFromContext",
+	Fn: func(r *request.Request) {
+		if v := userAgentProductsFromContext(r.Context()); len(v) > 0 {
+			request.AddToUserAgent(r, v.BuildUserAgentString())
+		}
+	},
+}
+
+// userAgentFromContextMiddleware is an AWS SDK for Go v2 middleware that appends
+// the resource information in Context to the User-Agent header.
+func userAgentFromContextMiddleware() middleware.BuildMiddleware {
+	return middleware.BuildMiddlewareFunc("tfUserAgentFromContext",
+		func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+			request, ok := in.Request.(*smithyhttp.Request)
+			if !ok {
+				return middleware.BuildOutput{}, middleware.Metadata{}, fmt.Errorf("unknown request type %T", in.Request)
+			}
+
+			if v := userAgentProductsFromContext(ctx); len(v) > 0 {
+				userAgent := v.BuildUserAgentString()
+				if current := request.Header.Get("User-Agent"); current != "" {
+					userAgent = current + " " + userAgent
+				}
+				request.Header.Set("User-Agent", userAgent)
+			}
+
+			return next.HandleBuild(ctx, in)
+		},
+	)
+}
+
+// withUserAgentFromContext is an AWS SDK for Go v2 API option that adds userAgentFromContextMiddleware.
+func withUserAgentFromContext(stack *middleware.Stack) error {
+	return stack.Build.Add(userAgentFromContextMiddleware(), middleware.After)
+}
+
+// ParseUserAgentProducts parses User-Agent products in the form "name/version (comment)".
+// Version and comment are optional.
+func ParseUserAgentProducts(tfList []string) (awsbase.UserAgentProducts, error) {
+	var products awsbase.UserAgentProducts
+	var errs []error
+
+	for _, v := range tfList {
+		var product awsbase.UserAgentProduct
+
+		s := strings.TrimSpace(v)
+		if i := strings.Index(s, "("); i != -1 {
+			if !strings.HasSuffix(s, ")") {
+				errs = append(errs, fmt.Errorf("invalid User-Agent product (%s): unterminated comment", v))
+				continue
+			}
+			product.Comment = strings.TrimSpace(s[i+1 : len(s)-1])
+			s = strings.TrimSpace(s[:i])
+		}
+
+		product.Name, product.Version, _ = strings.Cut(s, "/")
+
+		if product.Name == "" || strings.ContainsAny(s, " \t") {
+			errs = append(errs, fmt.Errorf("invalid User-Agent product (%s): expected \"name/version (comment)\"", v))
+			continue
+		}
+
+		products = append(products, product)
+	}
+
+	return products, errors.Join(errs...)
+}
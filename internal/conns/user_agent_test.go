@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestUserAgentProductsFromContext(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{
+			name: "no resource information",
+			ctx:  context.Background(),
+		},
+		{
+			name:     "resource",
+			ctx:      NewOperationContext(NewResourceContext(context.Background(), "ec2", "Subnet", "aws_subnet"), OperationCreate),
+			expected: "terraform-resource/aws_subnet (create)",
+		},
+		{
+			name:     "data source",
+			ctx:      NewOperationContext(NewDataSourceContext(context.Background(), "ec2", "Subnet", "aws_subnet"), OperationRead),
+			expected: "terraform-data-source/aws_subnet (read)",
+		},
+		{
+			name:     "no operation",
+			ctx:      NewResourceContext(context.Background(), "ec2", "Subnet", "aws_subnet"),
+			expected: "terraform-resource/aws_subnet",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := userAgentProductsFromContext(testCase.ctx).BuildUserAgentString(), testCase.expected; got != want {
+				t.Errorf("User-Agent = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNewOperationContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewResourceContext(context.Background(), "ec2", "Subnet", "aws_subnet")
+	createCtx := NewOperationContext(ctx, OperationCreate)
+
+	if v, _ := FromContext(ctx); v.Operation != "" {
+		t.Errorf("original Context modified: %q", v.Operation)
+	}
+	if v, _ := FromContext(createCtx); v.Operation != OperationCreate {
+		t.Errorf("Operation = %q, want %q", v.Operation, OperationCreate)
+	}
+	if _, ok := FromContext(NewOperationContext(context.Background(), OperationCreate)); ok {
+		t.Error("unexpected resource information in Context")
+	}
+}
+
+func TestUserAgentFromContextMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewOperationContext(NewResourceContext(context.Background(), "ec2", "Subnet", "aws_subnet"), OperationUpdate)
+	req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+	req.Header.Set("User-Agent", "APN/1.0")
+
+	_, _, err := userAgentFromContextMiddleware().HandleBuild(ctx, middleware.BuildInput{Request: req}, middleware.BuildHandlerFunc(
+		func(ctx context.Context, in middleware.BuildInput) (middleware.BuildOutput, middleware.Metadata, error) {
+			return middleware.BuildOutput{}, middleware.Metadata{}, nil
+		},
+	))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := req.Header.Get("User-Agent"), "APN/1.0 terraform-resource/aws_subnet (update)"; got != want {
+		t.Errorf("User-Agent = %q, want %q", got, want)
+	}
+}
+
+func TestUserAgentFromContextHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewOperationContext(NewResourceContext(context.Background(), "ec2", "Subnet", "aws_subnet"), OperationDelete)
+	r := &request.Request{
+		HTTPRequest: &http.Request{Header: http.Header{"User-Agent": []string{"APN/1.0"}}},
+	}
+	r.SetContext(ctx)
+
+	userAgentFromContextHandler.Fn(r)
+
+	if got, want := r.HTTPRequest.Header.Get("User-Agent"), "APN/1.0 terraform-resource/aws_subnet (delete)"; got != want {
+		t.Errorf("User-Agent = %q, want %q", got, want)
+	}
+}
+
+func TestParseUserAgentProducts(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       []string
+		expected    awsbase.UserAgentProducts
+		expectError bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "name and version",
+			input: []string{"pipeline/1234"},
+			expected: awsbase.UserAgentProducts{
+				{Name: "pipeline", Version: "1234"},
+			},
+		},
+		{
+			name:  "multiple",
+			input: []string{"pipeline/1234 (+https://example.com)", "team"},
+			expected: awsbase.UserAgentProducts{
+				{Name: "pipeline", Version: "1234", Comment: "+https://example.com"},
+				{Name: "team"},
+			},
+		},
+		{
+			name:        "whitespace",
+			input:       []string{"pipeline 1234"},
+			expectError: true,
+		},
+		{
+			name:        "unterminated comment",
+			input:       []string{"pipeline/1234 (comment"},
+			expectError: true,
+		},
+		{
+			name:        "no name",
+			input:       []string{"/1234"},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseUserAgentProducts(testCase.input)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParseUserAgentProducts() error = %v, expected error: %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"go/token"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -128,6 +129,11 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	ARNImport               bool
+	ARNImportService        string
+	ARNImportResourcePrefix string
+	ARNImportLastSegment    bool
+	ARNImportResourceSuffix string
 }
 
 type ServiceDatum struct {
@@ -228,6 +234,38 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ARNImport" {
+			args := common.ParseArgs(m[3])
+
+			if d.ARNImport {
+				v.err = multierror.Append(v.err, fmt.Errorf("multiple ARNImport annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.ARNImport = true
+
+			if attr, ok := args.Keyword["service"]; ok {
+				d.ARNImportService = attr
+			} else {
+				v.err = multierror.Append(v.err, fmt.Errorf("no service in ARNImport annotation: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["resourcePrefix"]; ok {
+				d.ARNImportResourcePrefix = attr
+			}
+
+			if attr, ok := args.Keyword["resourceSuffix"]; ok {
+				d.ARNImportResourceSuffix = attr
+			}
+
+			if attr, ok := args.Keyword["lastSegment"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid lastSegment value (%s): %s", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					d.ARNImportLastSegment = b
+				}
+			}
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -281,7 +319,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ARNImport", "Tags":
 				// Handled above.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+const (
+	dataFile = `staticdata.csv`
+	filename = `staticdata_gen.go`
+)
+
+const (
+	colKind = iota
+	colService
+	colPartition
+	colRegion
+	colValue
+)
+
+type EntryDatum struct {
+	Key   string // AWS Region, or partition for partition-wide values.
+	Value string
+}
+
+type ServiceDatum struct {
+	Service string
+	Entries []EntryDatum
+}
+
+type KindDatum struct {
+	Kind     string
+	Services []ServiceDatum
+}
+
+type TemplateData struct {
+	Kinds []KindDatum
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	g.Infof("Generating internal/staticdata/%s", filename)
+
+	data, err := common.ReadAllCSVData(dataFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", dataFile, err)
+	}
+
+	m := make(map[string]map[string][]EntryDatum)
+	seen := make(map[[3]string]bool)
+
+	for i, l := range data {
+		if i < 1 { // skip header
+			continue
+		}
+
+		kind, service, partition, region, value := l[colKind], l[colService], l[colPartition], l[colRegion], l[colValue]
+
+		if kind == "" || service == "" || partition == "" || value == "" {
+			g.Fatalf("%s line %d: kind, service, partition and value are required", dataFile, i+1)
+		}
+
+		key := region
+		if key == "" {
+			key = partition
+		}
+
+		if k := [3]string{kind, service, key}; seen[k] {
+			g.Fatalf("%s line %d: duplicate entry for %s %s (%s)", dataFile, i+1, kind, service, key)
+		} else {
+			seen[k] = true
+		}
+
+		if _, ok := m[kind]; !ok {
+			m[kind] = make(map[string][]EntryDatum)
+		}
+
+		m[kind][service] = append(m[kind][service], EntryDatum{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	td := TemplateData{}
+
+	for kind, services := range m {
+		kd := KindDatum{
+			Kind: kind,
+		}
+
+		for service, entries := range services {
+			sort.Slice(entries, func(i, j int) bool {
+				return entries[i].Key < entries[j].Key
+			})
+
+			kd.Services = append(kd.Services, ServiceDatum{
+				Service: service,
+				Entries: entries,
+			})
+		}
+
+		sort.Slice(kd.Services, func(i, j int) bool {
+			return kd.Services[i].Service < kd.Services[j].Service
+		})
+
+		td.Kinds = append(td.Kinds, kd)
+	}
+
+	sort.Slice(td.Kinds, func(i, j int) bool {
+		return td.Kinds[i].Kind < td.Kinds[j].Kind
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("staticdata", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed file.tmpl
+var tmpl string
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -168,6 +169,7 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, conns.OperationRead)
 	// TODO Run interceptors.
 	w.inner.Read(ctx, request, response)
 }
@@ -192,6 +194,8 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
+	// arnImport, if set, allows the resource to be imported using its ARN.
+	arnImport *types.ServicePackageResourceARNImport
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	inner            resource.ResourceWithConfigure
@@ -199,8 +203,9 @@ type wrappedResource struct {
 	meta             *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, arnImport *types.ServicePackageResourceARNImport) resource.ResourceWithConfigure {
 	return &wrappedResource{
+		arnImport:        arnImport,
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
@@ -223,6 +228,7 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, conns.OperationCreate)
 	diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -233,6 +239,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, conns.OperationRead)
 	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -243,6 +250,7 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, conns.OperationUpdate)
 	diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -253,6 +261,7 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, conns.OperationDelete)
 	diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -268,6 +277,20 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = conns.NewOperationContext(ctx, conns.OperationImport)
+
+		// If an ARN is specified as the import ID, translate it to the resource's ID.
+		if w.arnImport != nil && arn.IsARN(request.ID) {
+			id, err := w.arnImport.ResourceID(request.ID, w.meta.Partition, w.meta.Region, w.meta.AccountID)
+			if err != nil {
+				response.Diagnostics.AddError("Invalid Import ID", err.Error())
+
+				return
+			}
+
+			request.ID = id
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
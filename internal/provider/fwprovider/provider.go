@@ -155,6 +155,12 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"user_agent": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Product details to append to the User-Agent string sent in all AWS API calls,\n" +
+					"in the form `product_name/product_version (comment)`.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
@@ -279,6 +285,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"state_encryption": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to encrypt sensitive resource attributes stored in state.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The ARN, ID or alias of the KMS key used to encrypt sensitive attribute values.",
+						},
+						"pgp_key": schema.StringAttribute{
+							Optional: true,
+							Description: "The base64-encoded PGP public key, or a keybase username in the form `keybase:username`,\n" +
+								"used to encrypt sensitive attribute values.",
+						},
+						"pgp_private_key": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The base64-encoded PGP private key used to decrypt sensitive attribute values.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -324,7 +354,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -395,7 +425,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -433,8 +463,17 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.ARNImport != nil {
+				// The resource has opted in to ARN-based import.
+				// Ensure that the resource can be imported.
+				if _, ok := inner.(resource.ResourceWithImportState); !ok {
+					errs = append(errs, fmt.Errorf("ARN import configured but no importer defined: %s", typeName))
+					continue
+				}
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, v.ARNImport)
 			})
 		}
 	}
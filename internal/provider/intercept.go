@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -77,6 +78,22 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+// operation returns the name of the CRUD operation, which is recorded in Context.
+func (w why) operation() string {
+	switch w {
+	case Create:
+		return conns.OperationCreate
+	case Read:
+		return conns.OperationRead
+	case Update:
+		return conns.OperationUpdate
+	case Delete:
+		return conns.OperationDelete
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -91,6 +108,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		ctx = conns.NewOperationContext(ctx, why.operation())
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -148,6 +166,8 @@ func (ds *wrappedDataSource) Read(f schema.ReadContextFunc) schema.ReadContextFu
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
 type wrappedResource struct {
+	// arnImport, if set, allows the resource to be imported using its ARN.
+	arnImport *types.ServicePackageResourceARNImport
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
@@ -172,6 +192,18 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		ctx = conns.NewOperationContext(ctx, conns.OperationImport)
+
+		// If an ARN is specified as the import ID, translate it to the resource's ID.
+		if v := r.arnImport; v != nil && arn.IsARN(d.Id()) {
+			c := meta.(*conns.AWSClient)
+			id, err := v.ResourceID(d.Id(), c.Partition, c.Region, c.AccountID)
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+		}
 
 		return f(ctx, d, meta)
 	}
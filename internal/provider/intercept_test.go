@@ -9,7 +9,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestInterceptorsWhy(t *testing.T) {
@@ -93,3 +95,78 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestWrappedResourceStateARNImport(t *testing.T) {
+	t.Parallel()
+
+	r := &wrappedResource{
+		arnImport: &types.ServicePackageResourceARNImport{
+			Service:        "iam",
+			ResourcePrefix: "role/",
+			LastSegment:    true,
+		},
+		bootstrapContext: func(ctx context.Context, meta any) context.Context {
+			return ctx
+		},
+	}
+	f := r.State(schema.ImportStatePassthroughContext)
+
+	testCases := []struct {
+		TestName      string
+		ID            string
+		ExpectedID    string
+		ExpectedError bool
+	}{
+		{
+			TestName:   "ID",
+			ID:         "my-role",
+			ExpectedID: "my-role",
+		},
+		{
+			TestName:   "ARN",
+			ID:         "arn:aws:iam::123456789012:role/service-role/my-role",
+			ExpectedID: "my-role",
+		},
+		{
+			TestName:      "invalid ARN",
+			ID:            "arn:aws:iam::123456789012:user/my-user",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "other account",
+			ID:            "arn:aws:iam::210987654321:role/my-role",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+			d.SetId(testCase.ID)
+
+			_, err := f(ctx, d, &conns.AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+				Region:    "us-west-2",
+			})
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectedError {
+				if got, want := d.Id(), testCase.ExpectedID; got != want {
+					t.Errorf("ID = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
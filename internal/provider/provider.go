@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateencryption"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -221,6 +222,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"state_encryption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to encrypt sensitive resource attributes stored in state.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ARN, ID or alias of the KMS key used to encrypt sensitive attribute values.",
+						},
+						"pgp_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "The base64-encoded PGP public key, or a keybase username in the form `keybase:username`,\n" +
+								"used to encrypt sensitive attribute values.",
+						},
+						"pgp_private_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The base64-encoded PGP private key used to decrypt sensitive attribute values.",
+						},
+					},
+				},
+			},
 			"sts_region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -243,6 +271,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"user_agent": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Product details to append to the User-Agent string sent in all AWS API calls,\n" +
+					"in the form `product_name/product_version (comment)`.",
+			},
 		},
 
 		// Data sources and resources implemented using Terraform Plugin SDK
@@ -282,7 +317,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -359,7 +394,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -404,7 +439,40 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if _, ok := stateEncryptionResources[typeName]; ok {
+				schema := r.SchemaMap()
+				attributes := stateEncryptionAttributes(schema)
+
+				if len(attributes) == 0 {
+					errs = append(errs, fmt.Errorf("state encryption configured but no sensitive attributes defined: %s", typeName))
+					continue
+				}
+
+				for _, k := range attributes {
+					schema[k].DiffSuppressFunc = stateEncryptionDiffSuppressFunc(provider, schema[k].DiffSuppressFunc)
+				}
+				r.Schema, r.SchemaFunc = schema, nil
+
+				interceptors = append(interceptors, interceptorItem{
+					when: Before | After | OnError,
+					why:  AllOps,
+					interceptor: stateEncryptionInterceptor{
+						attributes: attributes,
+					},
+				})
+			}
+
+			if v.ARNImport != nil {
+				// The resource has opted in to ARN-based import.
+				// Ensure that the resource can be imported.
+				if r.Importer == nil || r.Importer.StateContext == nil {
+					errs = append(errs, fmt.Errorf("ARN import configured but no importer defined: %s", typeName))
+					continue
+				}
+			}
+
 			rs := &wrappedResource{
+				arnImport:        v.ARNImport,
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
 			}
@@ -566,6 +634,24 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("state_encryption"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.StateEncryptionConfig = expandStateEncryption(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err := config.StateEncryptionConfig.Validate(); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "state_encryption: %s", err)
+		}
+	}
+
+	if v, ok := d.GetOk("user_agent"); ok && len(v.([]interface{})) > 0 {
+		userAgent, err := conns.ParseUserAgentProducts(flex.ExpandStringValueList(v.([]interface{})))
+
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "user_agent: %s", err)
+		}
+
+		config.UserAgent = userAgent
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -869,6 +955,28 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandStateEncryption(_ context.Context, tfMap map[string]interface{}) *stateencryption.Config {
+	if tfMap == nil {
+		return nil
+	}
+
+	config := &stateencryption.Config{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok {
+		config.KMSKeyID = v
+	}
+
+	if v, ok := tfMap["pgp_key"].(string); ok {
+		config.PGPKey = v
+	}
+
+	if v, ok := tfMap["pgp_private_key"].(string); ok {
+		config.PGPPrivateKey = v
+	}
+
+	return config
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateencryption"
+)
+
+// stateEncryptionResources is the set of resources whose top-level Sensitive string attributes
+// are encrypted in state when the provider's `state_encryption` block is configured.
+// Note that aws_db_proxy is not included as its `auth` configuration references Secrets Manager secrets by ARN.
+var stateEncryptionResources = map[string]struct{}{
+	"aws_db_instance":                   {},
+	"aws_elasticache_replication_group": {},
+	"aws_rds_cluster":                   {},
+	"aws_secretsmanager_secret_version": {},
+}
+
+// stateEncryptionAttributes returns the names of the top-level Sensitive string attributes in the specified schema.
+func stateEncryptionAttributes(s map[string]*schema.Schema) []string {
+	var attributes []string
+
+	for k, v := range s {
+		if v.Sensitive && v.Type == schema.TypeString {
+			attributes = append(attributes, k)
+		}
+	}
+
+	return attributes
+}
+
+// stateEncryptionDiffSuppressFunc returns a DiffSuppressFunc that compares an encrypted value in state
+// with the corresponding plaintext value in configuration before calling any existing DiffSuppressFunc.
+// The encrypted value is decrypted using the provider's configured encrypter, which caches the plaintext of values
+// encrypted or decrypted when the resource was last read or written, so no digest of the plaintext is stored in state.
+func stateEncryptionDiffSuppressFunc(provider *schema.Provider, f schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if stateencryption.IsEncrypted(old) {
+			if v, ok := provider.Meta().(*conns.AWSClient); ok && v.StateEncrypter != nil {
+				// DiffSuppressFuncs have no context.
+				plaintext, err := v.StateEncrypter.Decrypt(context.TODO(), old)
+
+				if err != nil {
+					log.Printf("[WARN] decrypting %s: %s", k, err)
+				} else if subtle.ConstantTimeCompare([]byte(plaintext), []byte(new)) == 1 {
+					return true
+				}
+			}
+		}
+
+		if f != nil {
+			return f(k, old, new, d)
+		}
+
+		return false
+	}
+}
+
+type stateEncryptionContextKey int
+
+var decryptedValuesKey stateEncryptionContextKey
+
+// decryptedValue records the plaintext value decrypted from an encrypted value in state.
+type decryptedValue struct {
+	ciphertext string
+	plaintext  string
+}
+
+// stateEncryptionInterceptor implements transparent encryption of sensitive attribute values stored in state.
+type stateEncryptionInterceptor struct {
+	attributes []string
+}
+
+func (r stateEncryptionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	var encrypter stateencryption.Encrypter
+	if v, ok := meta.(*conns.AWSClient); ok {
+		encrypter = v.StateEncrypter
+	}
+
+	switch when {
+	case Before:
+		// Decrypt any encrypted values so that the resource's CRUD handlers see plaintext.
+		decryptedValues := make(map[string]decryptedValue)
+
+		for _, k := range r.attributes {
+			v, ok := d.Get(k).(string)
+			if !ok || !stateencryption.IsEncrypted(v) {
+				continue
+			}
+
+			if encrypter == nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "`%s` is encrypted in state but the provider `state_encryption` block is not configured", k)
+			}
+
+			plaintext, err := encrypter.Decrypt(ctx, v)
+			if err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "decrypting `%s`: %s", k, err)
+			}
+
+			if err := d.Set(k, plaintext); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+			}
+
+			decryptedValues[k] = decryptedValue{
+				ciphertext: v,
+				plaintext:  plaintext,
+			}
+		}
+
+		ctx = context.WithValue(ctx, decryptedValuesKey, decryptedValues)
+	case After, OnError:
+		if why == Delete || encrypter == nil {
+			break
+		}
+
+		decryptedValues, _ := ctx.Value(decryptedValuesKey).(map[string]decryptedValue)
+
+		for _, k := range r.attributes {
+			v, ok := d.Get(k).(string)
+			if !ok || v == "" || stateencryption.IsEncrypted(v) {
+				continue
+			}
+
+			var ciphertext string
+
+			// Encryption is not deterministic so reuse the existing ciphertext if the value hasn't changed.
+			if decryptedValue, ok := decryptedValues[k]; ok && decryptedValue.plaintext == v {
+				ciphertext = decryptedValue.ciphertext
+			} else {
+				var err error
+				ciphertext, err = encrypter.Encrypt(ctx, v)
+
+				if err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "encrypting `%s`: %s", k, err)
+				}
+			}
+
+			if err := d.Set(k, ciphertext); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
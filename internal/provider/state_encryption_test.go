@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// mockEncrypter produces a different ciphertext on each call to Encrypt.
+type mockEncrypter struct {
+	n int
+}
+
+func (e *mockEncrypter) Encrypt(_ context.Context, plaintext string) (string, error) {
+	e.n++
+	return fmt.Sprintf("tfenc:mock:%d:%s", e.n, plaintext), nil
+}
+
+func (e *mockEncrypter) Decrypt(_ context.Context, ciphertext string) (string, error) {
+	parts := strings.SplitN(ciphertext, ":", 4)
+	if len(parts) != 4 {
+		return "", errors.New("invalid ciphertext")
+	}
+	return parts[3], nil
+}
+
+func testStateEncryptionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func TestStateEncryptionAttributes(t *testing.T) {
+	t.Parallel()
+
+	got := stateEncryptionAttributes(testStateEncryptionSchema())
+
+	if len(got) != 1 || got[0] != "password" {
+		t.Errorf("stateEncryptionAttributes = %v, want [password]", got)
+	}
+}
+
+func TestStateEncryptionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptors := interceptorItems{
+		{
+			when: Before | After | OnError,
+			why:  AllOps,
+			interceptor: stateEncryptionInterceptor{
+				attributes: []string{"password"},
+			},
+		},
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+	ciphertext := "tfenc:mock:0:secret"
+
+	t.Run("unchanged value", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		meta := &conns.AWSClient{StateEncrypter: &mockEncrypter{}}
+		d := schema.TestResourceDataRaw(t, testStateEncryptionSchema(), map[string]any{"password": ciphertext})
+
+		diags := interceptedHandler(bootstrapContext, interceptors, func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			if got, want := d.Get("password").(string), "secret"; got != want {
+				t.Errorf("password in handler = %q, want %q", got, want)
+			}
+			return nil
+		}, Read)(ctx, d, meta)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if got, want := d.Get("password").(string), ciphertext; got != want {
+			t.Errorf("password = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("changed value", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		meta := &conns.AWSClient{StateEncrypter: &mockEncrypter{}}
+		d := schema.TestResourceDataRaw(t, testStateEncryptionSchema(), map[string]any{"password": ciphertext})
+
+		diags := interceptedHandler(bootstrapContext, interceptors, func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return diag.FromErr(d.Set("password", "updated"))
+		}, Update)(ctx, d, meta)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if got, want := d.Get("password").(string), "tfenc:mock:1:updated"; got != want {
+			t.Errorf("password = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("plaintext value", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		meta := &conns.AWSClient{StateEncrypter: &mockEncrypter{}}
+		d := schema.TestResourceDataRaw(t, testStateEncryptionSchema(), map[string]any{"name": "test", "password": "secret"})
+
+		diags := interceptedHandler(bootstrapContext, interceptors, func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return nil
+		}, Create)(ctx, d, meta)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if got, want := d.Get("password").(string), "tfenc:mock:1:secret"; got != want {
+			t.Errorf("password = %q, want %q", got, want)
+		}
+		if got, want := d.Get("name").(string), "test"; got != want {
+			t.Errorf("name = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		meta := &conns.AWSClient{}
+		d := schema.TestResourceDataRaw(t, testStateEncryptionSchema(), map[string]any{"password": ciphertext})
+
+		diags := interceptedHandler(bootstrapContext, interceptors, func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			t.Error("unexpected call to handler")
+			return nil
+		}, Read)(ctx, d, meta)
+
+		if !diags.HasError() {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestStateEncryptionDiffSuppressFunc(t *testing.T) {
+	t.Parallel()
+
+	provider := &schema.Provider{}
+	provider.SetMeta(&conns.AWSClient{StateEncrypter: &mockEncrypter{}})
+
+	f := stateEncryptionDiffSuppressFunc(provider, nil)
+
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Name:     "unchanged",
+			Old:      "tfenc:mock:1:secret",
+			New:      "secret",
+			Expected: true,
+		},
+		{
+			Name: "changed",
+			Old:  "tfenc:mock:1:secret",
+			New:  "updated",
+		},
+		{
+			Name: "invalid ciphertext",
+			Old:  "tfenc:mock",
+			New:  "tfenc:mock",
+		},
+		{
+			Name: "not encrypted",
+			Old:  "secret",
+			New:  "updated",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := f("password", testCase.Old, testCase.New, nil), testCase.Expected; got != want {
+				t.Errorf("DiffSuppressFunc = %t, want %t", got, want)
+			}
+		})
+	}
+}
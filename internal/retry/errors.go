@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// APIError is an AWS SDK version-independent view of an AWS API error.
+type APIError struct {
+	Code       string
+	Message    string
+	StatusCode int // Zero if the HTTP status code is not known.
+}
+
+// An ErrorAdapter extracts an APIError from an error returned by an AWS SDK.
+// It returns false if the error (or any wrapped error) is not recognized.
+type ErrorAdapter func(error) (APIError, bool)
+
+// SDKv1ErrorAdapter extracts an APIError from an AWS SDK for Go v1 error.
+func SDKv1ErrorAdapter(err error) (APIError, bool) {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return APIError{}, false
+	}
+
+	apiErr := APIError{
+		Code:    awsErr.Code(),
+		Message: awsErr.Message(),
+	}
+
+	var reqErr awserr.RequestFailure
+	if errors.As(err, &reqErr) {
+		apiErr.StatusCode = reqErr.StatusCode()
+	}
+
+	return apiErr, true
+}
+
+// SDKv2ErrorAdapter extracts an APIError from an AWS SDK for Go v2 error.
+func SDKv2ErrorAdapter(err error) (APIError, bool) {
+	var apiErr APIError
+	var ok bool
+
+	var smithyErr smithy.APIError
+	if errors.As(err, &smithyErr) {
+		apiErr.Code = smithyErr.ErrorCode()
+		apiErr.Message = smithyErr.ErrorMessage()
+		ok = true
+	}
+
+	var respErr *smithyhttp.ResponseError
+	if errors.As(err, &respErr) {
+		apiErr.StatusCode = respErr.HTTPStatusCode()
+		ok = true
+	}
+
+	return apiErr, ok
+}
+
+// errorAdapters are the adapters tried by AWS error predicates.
+var errorAdapters = []ErrorAdapter{
+	SDKv1ErrorAdapter,
+	SDKv2ErrorAdapter,
+}
+
+// apiErrorMatches returns true if any error adapter extracts an APIError satisfying f from the specified error.
+func apiErrorMatches(err error, f func(APIError) bool) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, adapter := range errorAdapters {
+		if apiErr, ok := adapter(err); ok && f(apiErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TimeoutError is returned when an operation is still being retried when its timeout elapses.
+type TimeoutError struct {
+	LastError error // The error returned by the last attempt, if any.
+}
+
+func (e *TimeoutError) Error() string {
+	if e.LastError == nil {
+		return context.DeadlineExceeded.Error()
+	}
+
+	return fmt.Sprintf("%s, last error: %s", context.DeadlineExceeded, e.LastError)
+}
+
+// Unwrap returns both the last attempt's error and context.DeadlineExceeded,
+// so that callers can test for either with errors.Is or errors.As.
+func (e *TimeoutError) Unwrap() []error {
+	if e.LastError == nil {
+		return []error{context.DeadlineExceeded}
+	}
+
+	return []error{e.LastError, context.DeadlineExceeded}
+}
+
+// TimedOut returns true if the error represents a retry loop timeout.
+func TimedOut(err error) bool {
+	var e *TimeoutError
+	return errors.As(err, &e)
+}
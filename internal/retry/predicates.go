@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"errors"
+	"strings"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// An ErrorPredicate reports whether an error returned by an operation is retryable.
+// ErrorPredicates are only invoked with non-nil errors.
+type ErrorPredicate func(error) bool
+
+// Or returns a predicate that is true if either p or other is true.
+func (p ErrorPredicate) Or(other ErrorPredicate) ErrorPredicate {
+	return Any(p, other)
+}
+
+// And returns a predicate that is true if both p and other are true.
+func (p ErrorPredicate) And(other ErrorPredicate) ErrorPredicate {
+	return All(p, other)
+}
+
+// Any returns a predicate that is true if any of the specified predicates is true.
+func Any(predicates ...ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if predicate(err) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// All returns a predicate that is true if all of the specified predicates are true.
+func All(predicates ...ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if !predicate(err) {
+				return false
+			}
+		}
+		return len(predicates) > 0
+	}
+}
+
+// Not returns a predicate that is true if the specified predicate is false.
+func Not(predicate ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		return !predicate(err)
+	}
+}
+
+// ErrCodeEquals returns a predicate that is true if the error is an AWS API error with one of the specified codes.
+func ErrCodeEquals(codes ...string) ErrorPredicate {
+	return func(err error) bool {
+		return apiErrorMatches(err, func(apiErr APIError) bool {
+			for _, code := range codes {
+				if apiErr.Code == code {
+					return true
+				}
+			}
+			return false
+		})
+	}
+}
+
+// ErrMessageContains returns a predicate that is true if the error is an AWS API error with the specified code
+// and a message containing the specified string.
+func ErrMessageContains(code, message string) ErrorPredicate {
+	return func(err error) bool {
+		return apiErrorMatches(err, func(apiErr APIError) bool {
+			return apiErr.Code == code && strings.Contains(apiErr.Message, message)
+		})
+	}
+}
+
+// ErrHTTPStatusCodeEquals returns a predicate that is true if the error is an AWS API error with one of the specified HTTP status codes.
+func ErrHTTPStatusCodeEquals(statusCodes ...int) ErrorPredicate {
+	return func(err error) bool {
+		return apiErrorMatches(err, func(apiErr APIError) bool {
+			for _, statusCode := range statusCodes {
+				if apiErr.StatusCode == statusCode {
+					return true
+				}
+			}
+			return false
+		})
+	}
+}
+
+// ErrContains returns a predicate that is true if the error's string value contains the specified string.
+func ErrContains(needle string) ErrorPredicate {
+	return func(err error) bool {
+		return errs.Contains(err, needle)
+	}
+}
+
+// NotFound returns a predicate that is true if the error represents a "resource not found" condition.
+func NotFound() ErrorPredicate {
+	return func(err error) bool {
+		var e *sdkretry.NotFoundError // nosemgrep:ci.is-not-found-error
+		return errors.As(err, &e)
+	}
+}
+
+// IsA returns a predicate that is true if the error (or any wrapped error) is of the specified type.
+func IsA[T error]() ErrorPredicate {
+	return func(err error) bool {
+		return errs.IsA[T](err)
+	}
+}
+
+// IsAErrorMessageContains returns a predicate that is true if the error (or any wrapped error) is of the specified type
+// and its ErrorMessage() value contains the specified string.
+func IsAErrorMessageContains[T errs.ErrorWithErrorMessage](needle string) ErrorPredicate {
+	return func(err error) bool {
+		return errs.IsAErrorMessageContains[T](err, needle)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type testError struct {
+	message string
+}
+
+func (e *testError) Error() string {
+	return e.message
+}
+
+func (e *testError) ErrorMessage() string {
+	return e.message
+}
+
+func TestErrorAdapters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Err           error
+		ExpectedError APIError
+		ExpectedOK    bool
+	}{
+		{
+			Name: "other error",
+			Err:  errors.New("TestCode"),
+		},
+		{
+			Name:          "SDK v1 error",
+			Err:           awserr.New("TestCode", "TestMessage", nil),
+			ExpectedError: APIError{Code: "TestCode", Message: "TestMessage"},
+			ExpectedOK:    true,
+		},
+		{
+			Name:          "SDK v1 request failure",
+			Err:           awserr.NewRequestFailure(awserr.New("TestCode", "TestMessage", nil), http.StatusConflict, "id"),
+			ExpectedError: APIError{Code: "TestCode", Message: "TestMessage", StatusCode: http.StatusConflict},
+			ExpectedOK:    true,
+		},
+		{
+			Name:          "wrapped SDK v2 error",
+			Err:           fmt.Errorf("wrapped: %w", &smithy.GenericAPIError{Code: "TestCode", Message: "TestMessage"}),
+			ExpectedError: APIError{Code: "TestCode", Message: "TestMessage"},
+			ExpectedOK:    true,
+		},
+		{
+			Name: "SDK v2 response error",
+			Err: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusConflict}},
+				Err:      &smithy.GenericAPIError{Code: "TestCode", Message: "TestMessage"},
+			},
+			ExpectedError: APIError{Code: "TestCode", Message: "TestMessage", StatusCode: http.StatusConflict},
+			ExpectedOK:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var got APIError
+			var ok bool
+			for _, adapter := range errorAdapters {
+				if got, ok = adapter(testCase.Err); ok {
+					break
+				}
+			}
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("ok = %t, want %t", ok, testCase.ExpectedOK)
+			}
+
+			if got != testCase.ExpectedError {
+				t.Errorf("APIError = %#v, want %#v", got, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	t.Parallel()
+
+	v1Err := awserr.New("TestCode1", "TestMessage1", nil)
+	v2Err := &smithyhttp.ResponseError{
+		Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+		Err:      &smithy.GenericAPIError{Code: "TestCode2", Message: "TestMessage2"},
+	}
+	notFoundErr := &sdkretry.NotFoundError{}
+	otherErr := errors.New("TestCode1: TestMessage1")
+
+	testCases := []struct {
+		Name      string
+		Predicate ErrorPredicate
+		Err       error
+		Expected  bool
+	}{
+		{
+			Name:      "ErrCodeEquals SDK v1",
+			Predicate: ErrCodeEquals("TestCode0", "TestCode1"),
+			Err:       v1Err,
+			Expected:  true,
+		},
+		{
+			Name:      "ErrCodeEquals SDK v2",
+			Predicate: ErrCodeEquals("TestCode2"),
+			Err:       v2Err,
+			Expected:  true,
+		},
+		{
+			Name:      "ErrCodeEquals no match",
+			Predicate: ErrCodeEquals("TestCode2"),
+			Err:       v1Err,
+		},
+		{
+			Name:      "ErrCodeEquals other error",
+			Predicate: ErrCodeEquals("TestCode1"),
+			Err:       otherErr,
+		},
+		{
+			Name:      "ErrMessageContains SDK v1",
+			Predicate: ErrMessageContains("TestCode1", "Message1"),
+			Err:       v1Err,
+			Expected:  true,
+		},
+		{
+			Name:      "ErrMessageContains SDK v2 wrong code",
+			Predicate: ErrMessageContains("TestCode1", "Message2"),
+			Err:       v2Err,
+		},
+		{
+			Name:      "ErrHTTPStatusCodeEquals SDK v2",
+			Predicate: ErrHTTPStatusCodeEquals(http.StatusTooManyRequests),
+			Err:       v2Err,
+			Expected:  true,
+		},
+		{
+			Name:      "ErrHTTPStatusCodeEquals SDK v1",
+			Predicate: ErrHTTPStatusCodeEquals(http.StatusTooManyRequests),
+			Err:       v1Err,
+		},
+		{
+			Name:      "ErrContains",
+			Predicate: ErrContains("TestMessage1"),
+			Err:       otherErr,
+			Expected:  true,
+		},
+		{
+			Name:      "NotFound",
+			Predicate: NotFound(),
+			Err:       fmt.Errorf("wrapped: %w", notFoundErr),
+			Expected:  true,
+		},
+		{
+			Name:      "NotFound other error",
+			Predicate: NotFound(),
+			Err:       otherErr,
+		},
+		{
+			Name:      "IsA",
+			Predicate: IsA[*testError](),
+			Err:       fmt.Errorf("wrapped: %w", &testError{message: "TestMessage"}),
+			Expected:  true,
+		},
+		{
+			Name:      "IsAErrorMessageContains",
+			Predicate: IsAErrorMessageContains[*testError]("Message"),
+			Err:       &testError{message: "TestMessage"},
+			Expected:  true,
+		},
+		{
+			Name:      "IsAErrorMessageContains no match",
+			Predicate: IsAErrorMessageContains[*testError]("Other"),
+			Err:       &testError{message: "TestMessage"},
+		},
+		{
+			Name:      "Or",
+			Predicate: NotFound().Or(ErrCodeEquals("TestCode1")),
+			Err:       v1Err,
+			Expected:  true,
+		},
+		{
+			Name:      "And",
+			Predicate: ErrCodeEquals("TestCode2").And(ErrHTTPStatusCodeEquals(http.StatusConflict)),
+			Err:       v2Err,
+		},
+		{
+			Name:      "Not",
+			Predicate: Not(NotFound()),
+			Err:       otherErr,
+			Expected:  true,
+		},
+		{
+			Name:      "All empty",
+			Predicate: All(),
+			Err:       otherErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.Predicate(testCase.Err), testCase.Expected; got != want {
+				t.Errorf("predicate = %t, want %t", got, want)
+			}
+		})
+	}
+}
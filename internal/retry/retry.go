@@ -14,13 +14,20 @@ import (
 
 // Options configure a retry loop.
 // Before the ith iteration of the loop, retry.Continue() sleeps for a duraion of BackoffMinDuration * BackoffMultiplier**i, with added jitter.
+// If BackoffMaxDuration is specified, the sleep duration (before jitter) is capped at that value.
+// If DisableJitter is true, no jitter is added.
+// If FinalAttempt is true, Operation.Run makes one final attempt after its timeout elapses.
 type Options struct {
 	BackoffMinDuration time.Duration
-	BackoffMultiplier  float64 // If specified, must be at least 1.
+	BackoffMaxDuration time.Duration // If specified, must be at least BackoffMinDuration.
+	BackoffMultiplier  float64       // If specified, must be at least 1.
+	DisableJitter      bool
+	FinalAttempt       bool
 }
 
 var defaultOptions = Options{
 	BackoffMinDuration: 10 * time.Millisecond,
+	BackoffMaxDuration: 10 * time.Second,
 	BackoffMultiplier:  1.3,
 }
 
@@ -46,7 +53,11 @@ func Begin() *Retry {
 // The first call does not sleep.
 func (r *Retry) Continue(ctx context.Context) bool {
 	if r.attempt != 0 {
-		randomizedSleep(ctx, r.backoffDelay())
+		if r.options.DisableJitter {
+			sleep(ctx, r.backoffDelay())
+		} else {
+			randomizedSleep(ctx, r.backoffDelay())
+		}
 	}
 	r.attempt++
 	return ctx.Err() == nil
@@ -60,7 +71,11 @@ func (r *Retry) Reset() {
 
 func (r *Retry) backoffDelay() time.Duration {
 	mult := math.Pow(r.options.BackoffMultiplier, float64(r.attempt))
-	return time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	delay := time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	if max := r.options.BackoffMaxDuration; max > 0 && (delay > max || delay < 0) {
+		delay = max
+	}
+	return delay
 }
 
 // Do not use the default RNG since we do not want different provider instances
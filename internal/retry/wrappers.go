@@ -8,8 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"time"
-
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 type Op[T any] interface {
@@ -22,6 +20,10 @@ func (f OpFunc[T]) Invoke(ctx context.Context) (T, error) {
 	return f(ctx)
 }
 
+// A Predicate decides whether an operation should be retried based on its result.
+// If the operation is to be retried, returns a bool value of `true` and an error (not necessarily the error passed as the argument).
+// The error is reported if the operation times out.
+// If the operation is not to be retried, returns a bool value of `false` and either no error (success state) or an error.
 type Predicate[T any] interface {
 	Invoke(T, error) (bool, error)
 }
@@ -34,6 +36,7 @@ func (f PredicateFunc[T]) Invoke(t T, err error) (bool, error) {
 
 type operation[T any] struct {
 	op                Op[T]
+	options           Options
 	predicate         Predicate[T]
 	transformRunError func(error) error
 }
@@ -41,10 +44,11 @@ type operation[T any] struct {
 // Operation returns a new wrapper on top of the specified function.
 func Operation[T any](op OpFunc[T]) operation[T] {
 	return operation[T]{
-		op: op,
+		op:      op,
+		options: defaultOptions,
 		// The default predicate short-circuits a retry loop if the operation returns any error.
 		predicate: PredicateFunc[T](func(t T, err error) (bool, error) {
-			return err != nil, err
+			return false, err
 		}),
 		// The default error transformer does nothing.
 		transformRunError: func(err error) error { return err },
@@ -52,17 +56,33 @@ func Operation[T any](op OpFunc[T]) operation[T] {
 }
 
 func (o operation[T]) withPredicate(predicate Predicate[T]) operation[T] {
-	return operation[T]{op: o.op, predicate: predicate, transformRunError: o.transformRunError}
+	return operation[T]{op: o.op, options: o.options, predicate: predicate, transformRunError: o.transformRunError}
 }
 
 func (o operation[T]) withTransformRunError(f func(error) error) operation[T] {
-	return operation[T]{op: o.op, predicate: o.predicate, transformRunError: f}
+	return operation[T]{op: o.op, options: o.options, predicate: o.predicate, transformRunError: f}
+}
+
+// WithOptions configures the backoff used between retries.
+func (o operation[T]) WithOptions(options Options) operation[T] {
+	return operation[T]{op: o.op, options: options, predicate: o.predicate, transformRunError: o.transformRunError}
 }
 
 func (o operation[T]) If(predicate PredicateFunc[T]) operation[T] {
 	return o.withPredicate(predicate)
 }
 
+// RetryWhen retries an operation if it returns an error satisfying the specified predicate.
+func (o operation[T]) RetryWhen(predicate ErrorPredicate) operation[T] {
+	return o.If(func(_ T, err error) (bool, error) {
+		if err != nil && predicate(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
 // UntilFoundN retries an operation if it returns a retry.NotFoundError.
 func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	if continuousTargetOccurence < 1 {
@@ -70,6 +90,7 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	}
 
 	targetOccurence := 0
+	notFound := NotFound()
 
 	predicate := func(_ T, err error) (bool, error) {
 		if err == nil {
@@ -82,7 +103,7 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) {
+		if notFound(err) {
 			targetOccurence = 0
 
 			return true, err
@@ -94,13 +115,19 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	return o.If(predicate)
 }
 
+// ErrFoundResource is the last error reported when an UntilNotFound operation times out.
+var ErrFoundResource = errors.New(`found resource`)
+
+// UntilNotFound retries an operation until it returns a retry.NotFoundError.
 func (o operation[T]) UntilNotFound() operation[T] {
+	notFound := NotFound()
+
 	predicate := func(_ T, err error) (bool, error) {
 		if err == nil {
-			return true, nil
+			return true, ErrFoundResource
 		}
 
-		if tfresource.NotFound(err) {
+		if notFound(err) {
 			return false, nil
 		}
 
@@ -108,8 +135,8 @@ func (o operation[T]) UntilNotFound() operation[T] {
 	}
 
 	transform := func(err error) error {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return fmt.Errorf("found resource: %w", err)
+		if errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, ErrFoundResource) {
+			return fmt.Errorf("%w: %w", ErrFoundResource, err)
 		}
 
 		return err
@@ -119,18 +146,39 @@ func (o operation[T]) UntilNotFound() operation[T] {
 }
 
 // Run retries an operation until the timeout elapses or predicate indicates otherwise.
+// If the timeout elapses a *TimeoutError wrapping the last error reported by the predicate is returned.
+// If Options.FinalAttempt is set, the operation is attempted once more after the timeout elapses before giving up.
 func (o operation[T]) Run(ctx context.Context, timeout time.Duration) (T, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	for r := Begin(); r.Continue(ctx); {
-		t, err := o.op.Invoke(ctx)
+	var lastErr error
+	for r := BeginWithOptions(o.options); r.Continue(runCtx); {
+		t, err := o.op.Invoke(runCtx)
 
-		if retry, err := o.predicate.Invoke(t, err); !retry {
+		retry, err := o.predicate.Invoke(t, err)
+		if !retry {
 			return t, err
 		}
+
+		lastErr = err
+	}
+
+	var zero T
+	if errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+		if o.options.FinalAttempt && ctx.Err() == nil {
+			t, err := o.op.Invoke(ctx)
+
+			retry, err := o.predicate.Invoke(t, err)
+			if !retry {
+				return t, err
+			}
+
+			lastErr = err
+		}
+
+		return zero, o.transformRunError(&TimeoutError{LastError: lastErr})
 	}
 
-	var t T
-	return t, o.transformRunError(ctx.Err())
+	return zero, o.transformRunError(runCtx.Err())
 }
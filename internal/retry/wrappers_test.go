@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var testOptions = Options{
+	BackoffMinDuration: time.Millisecond,
+	BackoffMaxDuration: 10 * time.Millisecond,
+	BackoffMultiplier:  2,
+}
+
+func TestOperationRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := Operation(func(context.Context) (string, error) {
+			return "ok", nil
+		}).WithOptions(testOptions).Run(ctx, time.Second)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != "ok" {
+			t.Errorf("got %q, want %q", got, "ok")
+		}
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		_, err := Operation(func(context.Context) (string, error) {
+			attempts++
+			return "", errors.New("failed")
+		}).WithOptions(testOptions).Run(ctx, time.Second)
+
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if attempts != 1 {
+			t.Errorf("attempts = %d, want 1", attempts)
+		}
+	})
+
+	t.Run("retryable error success", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		got, err := Operation(func(context.Context) (int, error) {
+			attempts++
+			if attempts < 3 {
+				return 0, awserr.New("Throttling", "Rate exceeded", nil)
+			}
+			return attempts, nil
+		}).RetryWhen(ErrCodeEquals("Throttling")).WithOptions(testOptions).Run(ctx, time.Second)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != 3 {
+			t.Errorf("got %d, want 3", got)
+		}
+	})
+
+	t.Run("retryable error timeout", func(t *testing.T) {
+		t.Parallel()
+
+		lastErr := awserr.New("Throttling", "Rate exceeded", nil)
+		_, err := Operation(func(context.Context) (int, error) {
+			return 0, lastErr
+		}).RetryWhen(ErrCodeEquals("Throttling")).WithOptions(testOptions).Run(ctx, 50*time.Millisecond)
+
+		if !TimedOut(err) {
+			t.Fatalf("expected timeout error, got: %v", err)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got: %v", err)
+		}
+		if !errors.Is(err, lastErr) {
+			t.Errorf("expected last error, got: %v", err)
+		}
+	})
+
+	t.Run("final attempt success", func(t *testing.T) {
+		t.Parallel()
+
+		options := testOptions
+		options.FinalAttempt = true
+
+		got, err := Operation(func(ctx context.Context) (int, error) {
+			// Only the final attempt is made without the timeout's deadline.
+			if _, ok := ctx.Deadline(); ok {
+				return 0, awserr.New("Throttling", "Rate exceeded", nil)
+			}
+			return 1, nil
+		}).RetryWhen(ErrCodeEquals("Throttling")).WithOptions(options).Run(ctx, 50*time.Millisecond)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != 1 {
+			t.Errorf("got %d, want 1", got)
+		}
+	})
+
+	t.Run("final attempt timeout", func(t *testing.T) {
+		t.Parallel()
+
+		options := testOptions
+		options.FinalAttempt = true
+
+		lastErr := awserr.New("Throttling", "Rate exceeded", nil)
+		_, err := Operation(func(context.Context) (int, error) {
+			return 0, lastErr
+		}).RetryWhen(ErrCodeEquals("Throttling")).WithOptions(options).Run(ctx, 50*time.Millisecond)
+
+		if !TimedOut(err) {
+			t.Fatalf("expected timeout error, got: %v", err)
+		}
+		if !errors.Is(err, lastErr) {
+			t.Errorf("expected last error, got: %v", err)
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := Operation(func(context.Context) (int, error) {
+			return 0, nil
+		}).WithOptions(testOptions).Run(ctx, time.Second)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got: %v", err)
+		}
+	})
+}
+
+func TestOperationUntilFoundN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attempts := 0
+	_, err := Operation(func(context.Context) (int, error) {
+		attempts++
+		if attempts == 2 {
+			return 0, &sdkretry.NotFoundError{}
+		}
+		return attempts, nil
+	}).UntilFoundN(2).WithOptions(testOptions).Run(ctx, time.Second)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attempts != 4 {
+		t.Errorf("attempts = %d, want 4", attempts)
+	}
+}
+
+func TestOperationUntilNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		_, err := Operation(func(context.Context) (int, error) {
+			attempts++
+			if attempts == 3 {
+				return 0, &sdkretry.NotFoundError{}
+			}
+			return attempts, nil
+		}).UntilNotFound().WithOptions(testOptions).Run(ctx, time.Second)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Operation(func(context.Context) (int, error) {
+			return 1, nil
+		}).UntilNotFound().WithOptions(testOptions).Run(ctx, 50*time.Millisecond)
+
+		if !errors.Is(err, ErrFoundResource) {
+			t.Errorf("expected ErrFoundResource, got: %v", err)
+		}
+	})
+}
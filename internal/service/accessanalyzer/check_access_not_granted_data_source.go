@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_accessanalyzer_check_access_not_granted", name="Check Access Not Granted")
+func dataSourceCheckAccessNotGranted() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCheckAccessNotGrantedRead,
+
+		Schema: map[string]*schema.Schema{
+			"access": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessCheckPolicyType](),
+			},
+			"reasons": reasonSummariesSchema(),
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCheckAccessNotGrantedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policyDocument := d.Get("policy_document").(string)
+	input := &accessanalyzer.CheckAccessNotGrantedInput{
+		Access:         expandAccesses(d.Get("access").([]interface{})),
+		PolicyDocument: aws.String(policyDocument),
+		PolicyType:     types.AccessCheckPolicyType(d.Get("policy_type").(string)),
+	}
+
+	output, err := conn.CheckAccessNotGranted(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer access not granted: %s", err)
+	}
+
+	var actions []string
+	for _, v := range input.Access {
+		actions = append(actions, v.Actions...)
+	}
+	d.SetId(strconv.Itoa(create.StringHashcode(policyDocument + string(input.PolicyType) + strings.Join(actions, ","))))
+	d.Set("message", output.Message)
+	if err := d.Set("reasons", flattenReasonSummaries(output.Reasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reasons: %s", err)
+	}
+	d.Set("result", output.Result)
+
+	return diags
+}
+
+func expandAccesses(tfList []interface{}) []types.Access {
+	var apiObjects []types.Access
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Access{}
+
+		if v, ok := tfMap["actions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Actions = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
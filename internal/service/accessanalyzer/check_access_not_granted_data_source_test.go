@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckAccessNotGrantedDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_access_not_granted.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAccessNotGrantedDataSourceConfig_basic("s3:DeleteBucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "PASS"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckAccessNotGrantedDataSourceConfig_basic("s3:GetObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reasons.0.description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessNotGrantedDataSourceConfig_basic(action string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_accessanalyzer_check_access_not_granted" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"
+    }]
+  })
+
+  access {
+    actions = [%[1]q]
+  }
+}
+`, action)
+}
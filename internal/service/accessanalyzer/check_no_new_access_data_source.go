@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_accessanalyzer_check_no_new_access", name="Check No New Access")
+func dataSourceCheckNoNewAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCheckNoNewAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"existing_policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"new_policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessCheckPolicyType](),
+			},
+			"reasons": reasonSummariesSchema(),
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCheckNoNewAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	existingPolicyDocument, newPolicyDocument := d.Get("existing_policy_document").(string), d.Get("new_policy_document").(string)
+	input := &accessanalyzer.CheckNoNewAccessInput{
+		ExistingPolicyDocument: aws.String(existingPolicyDocument),
+		NewPolicyDocument:      aws.String(newPolicyDocument),
+		PolicyType:             types.AccessCheckPolicyType(d.Get("policy_type").(string)),
+	}
+
+	output, err := conn.CheckNoNewAccess(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer no new access: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(existingPolicyDocument + newPolicyDocument + string(input.PolicyType))))
+	d.Set("message", output.Message)
+	if err := d.Set("reasons", flattenReasonSummaries(output.Reasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reasons: %s", err)
+	}
+	d.Set("result", output.Result)
+
+	return diags
+}
+
+func reasonSummariesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"statement_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"statement_index": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenReasonSummaries(apiObjects []types.ReasonSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"description":     aws.ToString(apiObject.Description),
+			"statement_id":    aws.ToString(apiObject.StatementId),
+			"statement_index": aws.ToInt32(apiObject.StatementIndex),
+		})
+	}
+
+	return tfList
+}
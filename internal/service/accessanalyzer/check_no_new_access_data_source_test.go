@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckNoNewAccessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_no_new_access.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNoNewAccessDataSourceConfig_basic("s3:GetObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "PASS"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "message"),
+				),
+			},
+			{
+				Config: testAccCheckNoNewAccessDataSourceConfig_basic("s3:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.0.statement_index", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNoNewAccessDataSourceConfig_basic(action string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_accessanalyzer_check_no_new_access" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  existing_policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"
+    }]
+  })
+
+  new_policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = %[1]q
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"
+    }]
+  })
+}
+`, action)
+}
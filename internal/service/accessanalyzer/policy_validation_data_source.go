@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_accessanalyzer_policy_validation", name="Policy Validation")
+func dataSourcePolicyValidation() *schema.Resource {
+	positionSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"column": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"line": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"offset": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"learn_more_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"span": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end":   positionSchema(),
+												"start": positionSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"locale": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.Locale](),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.PolicyType](),
+			},
+			"validate_policy_resource_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ValidatePolicyResourceType](),
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policyDocument := d.Get("policy_document").(string)
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policyDocument),
+		PolicyType:     types.PolicyType(d.Get("policy_type").(string)),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = types.Locale(v.(string))
+	}
+
+	if v, ok := d.GetOk("validate_policy_resource_type"); ok {
+		input.ValidatePolicyResourceType = types.ValidatePolicyResourceType(v.(string))
+	}
+
+	findings, err := FindPolicyValidationFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating IAM Access Analyzer policy: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policyDocument + string(input.PolicyType) + string(input.ValidatePolicyResourceType))))
+	if err := d.Set("findings", flattenValidatePolicyFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	return diags
+}
+
+// FindPolicyValidationFindings returns all findings from validating a policy with IAM Access Analyzer.
+func FindPolicyValidationFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput) ([]types.ValidatePolicyFinding, error) {
+	var output []types.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+// PolicyValidationFindingPath returns a JSONPath-like representation of a policy validation finding location, e.g. "Statement[0].Action[1]".
+func PolicyValidationFindingPath(apiObject types.Location) string {
+	var sb strings.Builder
+
+	for _, v := range apiObject.Path {
+		switch v := v.(type) {
+		case *types.PathElementMemberIndex:
+			fmt.Fprintf(&sb, "[%d]", v.Value)
+		case *types.PathElementMemberKey:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *types.PathElementMemberSubstring:
+			fmt.Fprintf(&sb, "[%d:%d]", aws.ToInt32(v.Value.Start), aws.ToInt32(v.Value.Start)+aws.ToInt32(v.Value.Length))
+		case *types.PathElementMemberValue:
+			fmt.Fprintf(&sb, "(%s)", v.Value)
+		}
+	}
+
+	return sb.String()
+}
+
+func flattenValidatePolicyFindings(apiObjects []types.ValidatePolicyFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.ToString(apiObject.FindingDetails),
+			"finding_type":    apiObject.FindingType,
+			"issue_code":      aws.ToString(apiObject.IssueCode),
+			"learn_more_link": aws.ToString(apiObject.LearnMoreLink),
+			"locations":       flattenLocations(apiObject.Locations),
+		})
+	}
+
+	return tfList
+}
+
+func flattenLocations(apiObjects []types.Location) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"path": PolicyValidationFindingPath(apiObject),
+		}
+
+		if v := apiObject.Span; v != nil {
+			tfMap["span"] = []interface{}{map[string]interface{}{
+				"end":   flattenPosition(v.End),
+				"start": flattenPosition(v.Start),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPosition(apiObject *types.Position) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"column": aws.ToInt32(apiObject.Column),
+		"line":   aws.ToInt32(apiObject.Line),
+		"offset": aws.ToInt32(apiObject.Offset),
+	}}
+}
@@ -23,7 +23,23 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceCheckAccessNotGranted,
+			TypeName: "aws_accessanalyzer_check_access_not_granted",
+			Name:     "Check Access Not Granted",
+		},
+		{
+			Factory:  dataSourceCheckNoNewAccess,
+			TypeName: "aws_accessanalyzer_check_no_new_access",
+			Name:     "Check No New Access",
+		},
+		{
+			Factory:  dataSourcePolicyValidation,
+			TypeName: "aws_accessanalyzer_policy_validation",
+			Name:     "Policy Validation",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -157,6 +157,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "apigateway",
+				ResourcePrefix: "/restapis/",
+			},
 		},
 		{
 			Factory:  ResourceRestAPIPolicy,
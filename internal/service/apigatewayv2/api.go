@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_apigatewayv2_api", name="API")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="apigateway", resourcePrefix="/apis/")
 func ResourceAPI() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAPICreate,
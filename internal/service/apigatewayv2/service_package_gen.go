@@ -54,6 +54,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "apigateway",
+				ResourcePrefix: "/apis/",
+			},
 		},
 		{
 			Factory:  ResourceAPIMapping,
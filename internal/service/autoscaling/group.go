@@ -37,6 +37,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 )
 
 // @SDKResource("aws_autoscaling_group")
+// @ARNImport(service="autoscaling", resourcePrefix="autoScalingGroup:", lastSegment=true)
 func ResourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -49,6 +49,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceGroup,
 			TypeName: "aws_autoscaling_group",
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "autoscaling",
+				ResourcePrefix: "autoScalingGroup:",
+				LastSegment:    true,
+			},
 		},
 		{
 			Factory:  ResourceGroupTag,
@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_cloudfront_distribution", name="Distribution")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="cloudfront", resourcePrefix="distribution/")
 func ResourceDistribution() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -8,12 +8,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfcloudtrail "github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
 )
 
 func TestAccCloudTrailServiceAccountDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	expectedAccountID := tfcloudtrail.ServiceAccountPerRegionMap[acctest.Region()]
+	expectedAccountID, _ := staticdata.AccountID(staticdata.ServiceCloudTrail, acctest.Region())
 
 	dataSourceName := "data.aws_cloudtrail_service_account.main"
 
@@ -35,7 +35,7 @@ func TestAccCloudTrailServiceAccountDataSource_basic(t *testing.T) {
 
 func TestAccCloudTrailServiceAccountDataSource_region(t *testing.T) {
 	ctx := acctest.Context(t)
-	expectedAccountID := tfcloudtrail.ServiceAccountPerRegionMap[acctest.Region()]
+	expectedAccountID, _ := staticdata.AccountID(staticdata.ServiceCloudTrail, acctest.Region())
 
 	dataSourceName := "data.aws_cloudtrail_service_account.regional"
 
@@ -48,6 +48,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "cloudwatch",
+				ResourcePrefix: "alarm:",
+			},
 		},
 		{
 			Factory:  ResourceMetricStream,
@@ -24,6 +24,7 @@ import (
 
 // @SDKResource("aws_codecommit_repository", name="Repository")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="codecommit")
 func ResourceRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryCreate,
@@ -53,6 +53,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service: "codecommit",
+			},
 		},
 		{
 			Factory:  ResourceTrigger,
@@ -82,6 +82,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cognito_user_pool",
 			Name:     "User Pool",
 			Tags:     &types.ServicePackageResourceTags{},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "cognito-idp",
+				ResourcePrefix: "userpool/",
+			},
 		},
 		{
 			Factory:  ResourceUserPoolDomain,
@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_cognito_user_pool", name="User Pool")
 // @Tags
+// @ARNImport(service="cognito-idp", resourcePrefix="userpool/")
 func ResourceUserPool() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPoolCreate,
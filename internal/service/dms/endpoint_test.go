@@ -2573,7 +2573,10 @@ resource "aws_dms_endpoint" "test" {
 
 func testAccEndpointConfig_dynamoDB(rName string) string {
 	return fmt.Sprintf(`
-data "aws_partition" "current" {}
+data "aws_static_data" "dms" {
+  kind    = "service_principal"
+  service = "dms"
+}
 
 resource "aws_dms_endpoint" "test" {
   endpoint_id         = %[1]q
@@ -2601,7 +2604,7 @@ resource "aws_iam_role" "iam_role" {
 		{
 			"Action": "sts:AssumeRole",
 			"Principal": {
-				"Service": "dms.${data.aws_partition.current.dns_suffix}"
+				"Service": "${data.aws_static_data.dms.value}"
 			},
 			"Effect": "Allow"
 		}
@@ -2639,7 +2642,10 @@ EOF
 
 func testAccEndpointConfig_dynamoDBUpdate(rName string) string {
 	return fmt.Sprintf(`
-data "aws_partition" "current" {}
+data "aws_static_data" "dms" {
+  kind    = "service_principal"
+  service = "dms"
+}
 
 resource "aws_dms_endpoint" "test" {
   endpoint_id         = %[1]q
@@ -2665,7 +2671,7 @@ resource "aws_iam_role" "iam_role" {
 		{
 			"Action": "sts:AssumeRole",
 			"Principal": {
-				"Service": "dms.${data.aws_partition.current.dns_suffix}"
+				"Service": "${data.aws_static_data.dms.value}"
 			},
 			"Effect": "Allow"
 		}
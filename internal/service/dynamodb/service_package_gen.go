@@ -57,6 +57,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "dynamodb",
+				ResourcePrefix: "table/",
+			},
 		},
 		{
 			Factory:  ResourceTableItem,
@@ -40,6 +40,7 @@ const (
 
 // @SDKResource("aws_dynamodb_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="dynamodb", resourcePrefix="table/")
 func ResourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -331,3 +331,26 @@ func verifiedAccessEndpointProtocol_Values() []string {
 		verifiedAccessEndpointProtocolHTTPS,
 	}
 }
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_StoreImageTaskResult.html.
+const (
+	storeImageTaskStateCompleted  = "Completed"
+	storeImageTaskStateFailed     = "Failed"
+	storeImageTaskStateInProgress = "InProgress"
+)
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_LocalGatewayRouteTable.html.
+const (
+	localGatewayRouteTableStateAvailable = "available"
+	localGatewayRouteTableStateDeleted   = "deleted"
+	localGatewayRouteTableStateDeleting  = "deleting"
+	localGatewayRouteTableStatePending   = "pending"
+)
+
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_LocalGatewayRouteTableVirtualInterfaceGroupAssociation.html.
+const (
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStateAssociated     = "associated"
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociated  = "disassociated"
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociating = "disassociating"
+	localGatewayRouteTableVirtualInterfaceGroupAssociationStatePending        = "pending"
+)
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ebs_fast_snapshot_restore", name="EBS Fast Snapshot Restore")
+func ResourceEBSFastSnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEBSFastSnapshotRestoreCreate,
+		ReadWithoutTimeout:   resourceEBSFastSnapshotRestoreRead,
+		DeleteWithoutTimeout: resourceEBSFastSnapshotRestoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEBSFastSnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	availabilityZone := d.Get("availability_zone").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+	id := EBSFastSnapshotRestoreCreateResourceID(availabilityZone, snapshotID)
+	input := &ec2.EnableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	}
+
+	log.Printf("[DEBUG] Creating EBS Fast Snapshot Restore: %s", input)
+	output, err := conn.EnableFastSnapshotRestoresWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = EnableFastSnapshotRestoreItemsError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EBS Fast Snapshot Restore (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitFastSnapshotRestoreEnabled(ctx, conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Fast Snapshot Restore (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEBSFastSnapshotRestoreRead(ctx, d, meta)...)
+}
+
+func resourceEBSFastSnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	availabilityZone, snapshotID, err := EBSFastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindFastSnapshotRestoreByTwoPartKey(ctx, conn, availabilityZone, snapshotID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Fast Snapshot Restore %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Fast Snapshot Restore (%s): %s", d.Id(), err)
+	}
+
+	d.Set("availability_zone", output.AvailabilityZone)
+	d.Set("snapshot_id", output.SnapshotId)
+	d.Set("state", output.State)
+
+	return diags
+}
+
+func resourceEBSFastSnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	availabilityZone, snapshotID, err := EBSFastSnapshotRestoreParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EBS Fast Snapshot Restore: %s", d.Id())
+	output, err := conn.DisableFastSnapshotRestoresWithContext(ctx, &ec2.DisableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	})
+
+	if err == nil && output != nil {
+		err = DisableFastSnapshotRestoreItemsError(output.Unsuccessful)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EBS Fast Snapshot Restore (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitFastSnapshotRestoreDisabled(ctx, conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Fast Snapshot Restore (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const ebsFastSnapshotRestoreIDSeparator = ","
+
+func EBSFastSnapshotRestoreCreateResourceID(availabilityZone, snapshotID string) string {
+	parts := []string{availabilityZone, snapshotID}
+	id := strings.Join(parts, ebsFastSnapshotRestoreIDSeparator)
+
+	return id
+}
+
+func EBSFastSnapshotRestoreParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, ebsFastSnapshotRestoreIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AVAILABILITY_ZONE%[2]sSNAPSHOT_ID", id, ebsFastSnapshotRestoreIDSeparator)
+}
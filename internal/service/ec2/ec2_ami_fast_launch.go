@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_ami_fast_launch", name="AMI Fast Launch")
+func ResourceAMIFastLaunch() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAMIFastLaunchCreate,
+		ReadWithoutTimeout:   resourceAMIFastLaunchRead,
+		UpdateWithoutTimeout: resourceAMIFastLaunchUpdate,
+		DeleteWithoutTimeout: resourceAMIFastLaunchDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"launch_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"max_parallel_launches": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(6),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.FastLaunchResourceTypeSnapshot,
+				ValidateFunc: validation.StringInSlice(ec2.FastLaunchResourceType_Values(), false),
+			},
+			"snapshot_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_resource_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAMIFastLaunchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	imageID := d.Get("image_id").(string)
+
+	if err := enableFastLaunch(ctx, conn, d, imageID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 AMI Fast Launch (%s): %s", imageID, err)
+	}
+
+	d.SetId(imageID)
+
+	return append(diags, resourceAMIFastLaunchRead(ctx, d, meta)...)
+}
+
+func resourceAMIFastLaunchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindFastLaunchImageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 AMI Fast Launch %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 AMI Fast Launch (%s): %s", d.Id(), err)
+	}
+
+	d.Set("image_id", output.ImageId)
+	if output.LaunchTemplate != nil {
+		if err := d.Set("launch_template", []interface{}{flattenFastLaunchLaunchTemplateSpecificationResponse(output.LaunchTemplate)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting launch_template: %s", err)
+		}
+	} else {
+		d.Set("launch_template", nil)
+	}
+	d.Set("max_parallel_launches", output.MaxParallelLaunches)
+	d.Set("owner_id", output.OwnerId)
+	d.Set("resource_type", output.ResourceType)
+	if output.SnapshotConfiguration != nil {
+		if err := d.Set("snapshot_configuration", []interface{}{flattenFastLaunchSnapshotConfigurationResponse(output.SnapshotConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting snapshot_configuration: %s", err)
+		}
+	} else {
+		d.Set("snapshot_configuration", nil)
+	}
+	d.Set("state", output.State)
+
+	return diags
+}
+
+func resourceAMIFastLaunchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Calling EnableFastLaunch on an image that already has faster launching enabled updates its configuration.
+	if err := enableFastLaunch(ctx, conn, d, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 AMI Fast Launch (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAMIFastLaunchRead(ctx, d, meta)...)
+}
+
+func resourceAMIFastLaunchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 AMI Fast Launch: %s", d.Id())
+	_, err := conn.DisableFastLaunchWithContext(ctx, &ec2.DisableFastLaunchInput{
+		ImageId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 AMI Fast Launch (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitFastLaunchImageDisabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 AMI Fast Launch (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func enableFastLaunch(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, imageID string, timeout time.Duration) error {
+	input := &ec2.EnableFastLaunchInput{
+		ImageId:      aws.String(imageID),
+		ResourceType: aws.String(d.Get("resource_type").(string)),
+	}
+
+	if v, ok := d.GetOk("launch_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LaunchTemplate = expandFastLaunchLaunchTemplateSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("max_parallel_launches"); ok {
+		input.MaxParallelLaunches = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("snapshot_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SnapshotConfiguration = expandFastLaunchSnapshotConfigurationRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Enabling EC2 AMI Fast Launch: %s", input)
+	_, err := conn.EnableFastLaunchWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitFastLaunchImageEnabled(ctx, conn, imageID, timeout); err != nil {
+		return fmt.Errorf("waiting for enable: %w", err)
+	}
+
+	return nil
+}
+
+func expandFastLaunchLaunchTemplateSpecificationRequest(tfMap map[string]interface{}) *ec2.FastLaunchLaunchTemplateSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.FastLaunchLaunchTemplateSpecificationRequest{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.LaunchTemplateId = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.LaunchTemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFastLaunchSnapshotConfigurationRequest(tfMap map[string]interface{}) *ec2.FastLaunchSnapshotConfigurationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.FastLaunchSnapshotConfigurationRequest{}
+
+	if v, ok := tfMap["target_resource_count"].(int); ok && v != 0 {
+		apiObject.TargetResourceCount = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFastLaunchLaunchTemplateSpecificationResponse(apiObject *ec2.FastLaunchLaunchTemplateSpecificationResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LaunchTemplateId; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LaunchTemplateName; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Version; v != nil {
+		tfMap["version"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFastLaunchSnapshotConfigurationResponse(apiObject *ec2.FastLaunchSnapshotConfigurationResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TargetResourceCount; v != nil {
+		tfMap["target_resource_count"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2AMIFastLaunch_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_ami_fast_launch.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIFastLaunchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIFastLaunchConfig_basic(rName, 6, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "aws_ami_copy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "max_parallel_launches", "6"),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "snapshot"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.0.target_resource_count", "5"),
+					resource.TestCheckResourceAttr(resourceName, "state", "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAMIFastLaunchConfig_basic(rName, 8, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_parallel_launches", "8"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.0.target_resource_count", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAMIFastLaunchExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 AMI Fast Launch ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindFastLaunchImageByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAMIFastLaunchDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_ami_fast_launch" {
+				continue
+			}
+
+			_, err := tfec2.FindFastLaunchImageByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 AMI Fast Launch %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAMIFastLaunchConfig_basic(rName string, maxParallelLaunches, targetResourceCount int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_ami" "windows" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["Windows_Server-2022-English-Full-Base-*"]
+  }
+}
+
+resource "aws_ami_copy" "test" {
+  name              = %[1]q
+  source_ami_id     = data.aws_ami.windows.id
+  source_ami_region = data.aws_region.current.name
+}
+
+resource "aws_ec2_ami_fast_launch" "test" {
+  image_id              = aws_ami_copy.test.id
+  max_parallel_launches = %[2]d
+
+  snapshot_configuration {
+    target_resource_count = %[3]d
+  }
+}
+`, rName, maxParallelLaunches, targetResourceCount)
+}
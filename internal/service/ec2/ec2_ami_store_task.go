@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_ami_store_task", name="AMI Store Task")
+func ResourceAMIStoreTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAMIStoreTaskCreate,
+		ReadWithoutTimeout:   resourceAMIStoreTaskRead,
+		DeleteWithoutTimeout: resourceAMIStoreTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ami_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"progress_percentage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_object_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_object_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"store_task_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAMIStoreTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	imageID := d.Get("ami_id").(string)
+	input := &ec2.CreateStoreImageTaskInput{
+		Bucket:  aws.String(d.Get("bucket").(string)),
+		ImageId: aws.String(imageID),
+	}
+
+	if v, ok := d.GetOk("s3_object_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.S3ObjectTags = expandS3ObjectTags(v.(map[string]interface{}))
+	}
+
+	_, err := conn.CreateStoreImageTaskWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 AMI Store Task (%s): %s", imageID, err)
+	}
+
+	d.SetId(imageID)
+
+	if _, err := WaitStoreImageTaskCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 AMI Store Task (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAMIStoreTaskRead(ctx, d, meta)...)
+}
+
+func resourceAMIStoreTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	task, err := FindStoreImageTaskByImageID(ctx, conn, d.Id())
+
+	// Store image task results are only available for 31 days after the task starts.
+	// The stored object outlives the task, so keep the last known state.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 AMI Store Task %s no longer available, keeping last known state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 AMI Store Task (%s): %s", d.Id(), err)
+	}
+
+	d.Set("ami_id", task.AmiId)
+	d.Set("bucket", task.Bucket)
+	d.Set("progress_percentage", task.ProgressPercentage)
+	d.Set("s3_object_key", task.S3objectKey)
+	d.Set("store_task_state", task.StoreTaskState)
+	if task.TaskStartTime != nil {
+		d.Set("task_start_time", aws.TimeValue(task.TaskStartTime).Format(time.RFC3339))
+	} else {
+		d.Set("task_start_time", nil)
+	}
+
+	return diags
+}
+
+func resourceAMIStoreTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Deleting an EC2 AMI Store Task only removes it from state, the stored S3 object is left in place: %s", d.Id())
+
+	return nil // nosemgrep:ci.semgrep.pluginsdk.return-diags-not-nil
+}
+
+func expandS3ObjectTags(tfMap map[string]interface{}) []*ec2.S3ObjectTag {
+	var apiObjects []*ec2.S3ObjectTag
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &ec2.S3ObjectTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CapacityReservationFleetDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_capacity_reservation_fleet.test"
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "allocation_strategy", resourceName, "allocation_strategy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "capacity_reservation_fleet_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "create_time", resourceName, "create_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_match_criteria", resourceName, "instance_match_criteria"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_type_specification.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instance_type_specification.0.capacity_reservation_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tenancy", resourceName, "tenancy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "total_target_capacity", resourceName, "total_target_capacity"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleetDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_capacity_reservation_fleet.test"
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "capacity_reservation_fleet_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityReservationFleetDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfig_basic(rName, 2), `
+data "aws_ec2_capacity_reservation_fleet" "test" {
+  capacity_reservation_fleet_id = aws_ec2_capacity_reservation_fleet.test.id
+}
+`)
+}
+
+func testAccCapacityReservationFleetDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccCapacityReservationFleetConfig_basic(rName, 2), `
+data "aws_ec2_capacity_reservation_fleet" "test" {
+  filter {
+    name   = "tag:Name"
+    values = [aws_ec2_capacity_reservation_fleet.test.tags["Name"]]
+  }
+}
+`)
+}
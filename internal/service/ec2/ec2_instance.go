@@ -41,6 +41,7 @@ import (
 
 // @SDKResource("aws_instance", name="Instance")
 // @Tags(identifierAttribute="id")
+// @ARNImport(service="ec2", resourcePrefix="instance/")
 func ResourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_instance_event_window", name="Instance Event Window")
+// @Tags(identifierAttribute="id")
+func ResourceInstanceEventWindow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceEventWindowCreate,
+		ReadWithoutTimeout:   resourceInstanceEventWindowRead,
+		UpdateWithoutTimeout: resourceInstanceEventWindowUpdate,
+		DeleteWithoutTimeout: resourceInstanceEventWindowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cron_expression": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cron_expression", "time_range"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"time_range": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"cron_expression", "time_range"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"end_week_day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ec2.WeekDay_Values(), false),
+						},
+						"start_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_week_day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ec2.WeekDay_Values(), false),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInstanceEventWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.CreateInstanceEventWindowInput{
+		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypeInstanceEventWindow),
+	}
+
+	if v, ok := d.GetOk("cron_expression"); ok {
+		input.CronExpression = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("time_range"); ok && v.(*schema.Set).Len() > 0 {
+		input.TimeRanges = expandInstanceEventWindowTimeRangeRequests(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateInstanceEventWindowWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Instance Event Window: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.InstanceEventWindow.InstanceEventWindowId))
+
+	if _, err := WaitInstanceEventWindowCreated(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance Event Window (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInstanceEventWindowRead(ctx, d, meta)...)
+}
+
+func resourceInstanceEventWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	window, err := FindInstanceEventWindowByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance Event Window (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Event Window (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("instance-event-window/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cron_expression", window.CronExpression)
+	d.Set("name", window.Name)
+	d.Set("state", window.State)
+	if err := d.Set("time_range", flattenInstanceEventWindowTimeRanges(window.TimeRanges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting time_range: %s", err)
+	}
+
+	setTagsOut(ctx, window.Tags)
+
+	return diags
+}
+
+func resourceInstanceEventWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifyInstanceEventWindowInput{
+			InstanceEventWindowId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if v, ok := d.GetOk("cron_expression"); ok {
+			input.CronExpression = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("time_range"); ok && v.(*schema.Set).Len() > 0 {
+			input.TimeRanges = expandInstanceEventWindowTimeRangeRequests(v.(*schema.Set).List())
+		}
+
+		_, err := conn.ModifyInstanceEventWindowWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance Event Window (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstanceEventWindowRead(ctx, d, meta)...)
+}
+
+func resourceInstanceEventWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Instance Event Window: %s", d.Id())
+	_, err := conn.DeleteInstanceEventWindowWithContext(ctx, &ec2.DeleteInstanceEventWindowInput{
+		InstanceEventWindowId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceEventWindowIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Instance Event Window (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitInstanceEventWindowDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance Event Window (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandInstanceEventWindowTimeRangeRequest(tfMap map[string]interface{}) *ec2.InstanceEventWindowTimeRangeRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.InstanceEventWindowTimeRangeRequest{}
+
+	if v, ok := tfMap["end_hour"].(int); ok {
+		apiObject.EndHour = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["end_week_day"].(string); ok && v != "" {
+		apiObject.EndWeekDay = aws.String(v)
+	}
+
+	if v, ok := tfMap["start_hour"].(int); ok {
+		apiObject.StartHour = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_week_day"].(string); ok && v != "" {
+		apiObject.StartWeekDay = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInstanceEventWindowTimeRangeRequests(tfList []interface{}) []*ec2.InstanceEventWindowTimeRangeRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ec2.InstanceEventWindowTimeRangeRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandInstanceEventWindowTimeRangeRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenInstanceEventWindowTimeRange(apiObject *ec2.InstanceEventWindowTimeRange) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EndHour; v != nil {
+		tfMap["end_hour"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.EndWeekDay; v != nil {
+		tfMap["end_week_day"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StartHour; v != nil {
+		tfMap["start_hour"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.StartWeekDay; v != nil {
+		tfMap["start_week_day"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenInstanceEventWindowTimeRanges(apiObjects []*ec2.InstanceEventWindowTimeRange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenInstanceEventWindowTimeRange(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_instance_event_window_association", name="Instance Event Window Association")
+func ResourceInstanceEventWindowAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceEventWindowAssociationCreate,
+		ReadWithoutTimeout:   resourceInstanceEventWindowAssociationRead,
+		DeleteWithoutTimeout: resourceInstanceEventWindowAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dedicated_host_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"dedicated_host_ids", "instance_ids", "instance_tags"},
+			},
+			"instance_event_window_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"dedicated_host_ids", "instance_ids", "instance_tags"},
+			},
+			"instance_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"dedicated_host_ids", "instance_ids", "instance_tags"},
+			},
+		},
+	}
+}
+
+func resourceInstanceEventWindowAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	windowID := d.Get("instance_event_window_id").(string)
+	target := &ec2.InstanceEventWindowAssociationRequest{}
+
+	if v, ok := d.GetOk("dedicated_host_ids"); ok && v.(*schema.Set).Len() > 0 {
+		target.DedicatedHostIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok && v.(*schema.Set).Len() > 0 {
+		target.InstanceIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("instance_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		target.InstanceTags = Tags(tftags.New(ctx, v.(map[string]interface{})))
+	}
+
+	input := &ec2.AssociateInstanceEventWindowInput{
+		AssociationTarget:     target,
+		InstanceEventWindowId: aws.String(windowID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Instance Event Window Association: %s", input)
+	_, err := conn.AssociateInstanceEventWindowWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Instance Event Window (%s) Association: %s", windowID, err)
+	}
+
+	d.SetId(windowID)
+
+	return append(diags, resourceInstanceEventWindowAssociationRead(ctx, d, meta)...)
+}
+
+func resourceInstanceEventWindowAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	target, err := FindInstanceEventWindowAssociationTargetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance Event Window Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Event Window Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("dedicated_host_ids", aws.StringValueSlice(target.DedicatedHostIds))
+	d.Set("instance_event_window_id", d.Id())
+	d.Set("instance_ids", aws.StringValueSlice(target.InstanceIds))
+	if len(target.Tags) > 0 {
+		d.Set("instance_tags", KeyValueTags(ctx, target.Tags).Map())
+	} else {
+		d.Set("instance_tags", nil)
+	}
+
+	return diags
+}
+
+func resourceInstanceEventWindowAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	target := &ec2.InstanceEventWindowDisassociationRequest{}
+
+	if v, ok := d.GetOk("dedicated_host_ids"); ok && v.(*schema.Set).Len() > 0 {
+		target.DedicatedHostIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok && v.(*schema.Set).Len() > 0 {
+		target.InstanceIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("instance_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		target.InstanceTags = Tags(tftags.New(ctx, v.(map[string]interface{})))
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Instance Event Window Association: %s", d.Id())
+	_, err := conn.DisassociateInstanceEventWindowWithContext(ctx, &ec2.DisassociateInstanceEventWindowInput{
+		AssociationTarget:     target,
+		InstanceEventWindowId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceEventWindowIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Instance Event Window Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2InstanceEventWindowAssociation_instanceTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_event_window_association.test"
+	windowResourceName := "aws_ec2_instance_event_window.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceEventWindowAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceEventWindowAssociationConfig_instanceTags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceEventWindowAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_host_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_event_window_id", windowResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceEventWindowAssociation_instanceIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_event_window_association.test"
+	instanceResourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceEventWindowAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceEventWindowAssociationConfig_instanceIDs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceEventWindowAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "instance_ids.*", instanceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "instance_tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceEventWindowAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_event_window_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceEventWindowAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceEventWindowAssociationConfig_instanceTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceEventWindowAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceInstanceEventWindowAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceEventWindowAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Instance Event Window Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindInstanceEventWindowAssociationTargetByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInstanceEventWindowAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_instance_event_window_association" {
+				continue
+			}
+
+			_, err := tfec2.FindInstanceEventWindowAssociationTargetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Instance Event Window Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceEventWindowAssociationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_event_window" "test" {
+  name            = %[1]q
+  cron_expression = "* 21-23 * * 2,3"
+}
+`, rName)
+}
+
+func testAccInstanceEventWindowAssociationConfig_instanceTags(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceEventWindowAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_instance_event_window_association" "test" {
+  instance_event_window_id = aws_ec2_instance_event_window.test.id
+
+  instance_tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceEventWindowAssociationConfig_instanceIDs(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceEventWindowAssociationConfig_base(rName),
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_instance_event_window_association" "test" {
+  instance_event_window_id = aws_ec2_instance_event_window.test.id
+  instance_ids             = [aws_instance.test.id]
+}
+`, rName))
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2InstanceEventWindow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_event_window.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceEventWindowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceEventWindowConfig_cronExpression(rName, "* 21-23 * * 2,3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexache.MustCompile(`instance-event-window/iew-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", "* 21-23 * * 2,3"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "time_range.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceEventWindowConfig_cronExpression(rName, "* 0-2 * * 6"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", "* 0-2 * * 6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceEventWindow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_event_window.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceEventWindowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceEventWindowConfig_cronExpression(rName, "* 21-23 * * 2,3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceInstanceEventWindow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceEventWindow_timeRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_event_window.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceEventWindowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceEventWindowConfig_timeRange(rName, 1, "monday", 4, "monday"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", ""),
+					resource.TestCheckResourceAttr(resourceName, "time_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "time_range.*", map[string]string{
+						"end_hour":       "4",
+						"end_week_day":   "monday",
+						"start_hour":     "1",
+						"start_week_day": "monday",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceEventWindowConfig_timeRange(rName, 20, "friday", 2, "saturday"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "time_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "time_range.*", map[string]string{
+						"end_hour":       "2",
+						"end_week_day":   "saturday",
+						"start_hour":     "20",
+						"start_week_day": "friday",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceEventWindow_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_event_window.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceEventWindowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceEventWindowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceEventWindowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccInstanceEventWindowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceEventWindowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceEventWindowExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Instance Event Window ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindInstanceEventWindowByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInstanceEventWindowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_instance_event_window" {
+				continue
+			}
+
+			_, err := tfec2.FindInstanceEventWindowByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Instance Event Window %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceEventWindowConfig_cronExpression(rName, cronExpression string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_event_window" "test" {
+  name            = %[1]q
+  cron_expression = %[2]q
+}
+`, rName, cronExpression)
+}
+
+func testAccInstanceEventWindowConfig_timeRange(rName string, startHour int, startWeekDay string, endHour int, endWeekDay string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_event_window" "test" {
+  name = %[1]q
+
+  time_range {
+    start_hour     = %[2]d
+    start_week_day = %[3]q
+    end_hour       = %[4]d
+    end_week_day   = %[5]q
+  }
+}
+`, rName, startHour, startWeekDay, endHour, endWeekDay)
+}
+
+func testAccInstanceEventWindowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_event_window" "test" {
+  name            = %[1]q
+  cron_expression = "* 21-23 * * 2,3"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccInstanceEventWindowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_event_window" "test" {
+  name            = %[1]q
+  cron_expression = "* 21-23 * * 2,3"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
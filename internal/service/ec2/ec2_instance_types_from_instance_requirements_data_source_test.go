@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2InstanceTypesFromInstanceRequirementsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instance_types_from_instance_requirements.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypesFromInstanceRequirementsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "instance_types.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceTypesFromInstanceRequirementsDataSource_allowedInstanceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instance_types_from_instance_requirements.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypesFromInstanceRequirementsDataSourceConfig_allowedInstanceTypes(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0", "m5.large"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceTypesFromInstanceRequirementsDataSourceConfig_basic() string {
+	return `
+data "aws_ec2_instance_types_from_instance_requirements" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  instance_requirements {
+    memory_mib {
+      min = 4096
+      max = 8192
+    }
+
+    vcpu_count {
+      min = 2
+      max = 4
+    }
+  }
+}
+`
+}
+
+func testAccInstanceTypesFromInstanceRequirementsDataSourceConfig_allowedInstanceTypes() string {
+	return `
+data "aws_ec2_instance_types_from_instance_requirements" "test" {
+  architecture_types   = ["x86_64"]
+  virtualization_types = ["hvm"]
+
+  instance_requirements {
+    allowed_instance_types = ["m5.large"]
+
+    memory_mib {
+      min = 1024
+    }
+
+    vcpu_count {
+      min = 1
+    }
+  }
+}
+`
+}
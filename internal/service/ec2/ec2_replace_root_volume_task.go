@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_replace_root_volume_task", name="Replace Root Volume Task")
+// @Tags(identifierAttribute="id")
+func ResourceReplaceRootVolumeTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplaceRootVolumeTaskCreate,
+		ReadWithoutTimeout:   resourceReplaceRootVolumeTaskRead,
+		UpdateWithoutTimeout: resourceReplaceRootVolumeTaskUpdate,
+		DeleteWithoutTimeout: resourceReplaceRootVolumeTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"complete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_replaced_root_volume": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"image_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id"},
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"image_id"},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"task_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReplaceRootVolumeTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	instanceID := d.Get("instance_id").(string)
+	input := &ec2.CreateReplaceRootVolumeTaskInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		InstanceId:        aws.String(instanceID),
+		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypeReplaceRootVolumeTask),
+	}
+
+	if v, ok := d.GetOk("delete_replaced_root_volume"); ok {
+		input.DeleteReplacedRootVolume = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		input.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		input.SnapshotId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateReplaceRootVolumeTaskWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Replace Root Volume Task (%s): %s", instanceID, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplaceRootVolumeTask.ReplaceRootVolumeTaskId))
+
+	if _, err := WaitReplaceRootVolumeTaskSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Replace Root Volume Task (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReplaceRootVolumeTaskRead(ctx, d, meta)...)
+}
+
+func resourceReplaceRootVolumeTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	task, err := FindReplaceRootVolumeTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Replace Root Volume Task %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Replace Root Volume Task (%s): %s", d.Id(), err)
+	}
+
+	d.Set("complete_time", task.CompleteTime)
+	d.Set("delete_replaced_root_volume", task.DeleteReplacedRootVolume)
+	d.Set("image_id", task.ImageId)
+	d.Set("instance_id", task.InstanceId)
+	d.Set("snapshot_id", task.SnapshotId)
+	d.Set("start_time", task.StartTime)
+	d.Set("task_state", task.TaskState)
+
+	setTagsOut(ctx, task.Tags)
+
+	return diags
+}
+
+func resourceReplaceRootVolumeTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceReplaceRootVolumeTaskRead(ctx, d, meta)...)
+}
+
+func resourceReplaceRootVolumeTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Deleting an EC2 Replace Root Volume Task only removes it from state, the instance keeps its replaced root volume: %s", d.Id())
+
+	return nil // nosemgrep:ci.semgrep.pluginsdk.return-diags-not-nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2ReplaceRootVolumeTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.ReplaceRootVolumeTask
+	resourceName := "aws_ec2_replace_root_volume_task.test"
+	instanceResourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplaceRootVolumeTaskConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delete_replaced_root_volume", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", instanceResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task_state", "succeeded"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2ReplaceRootVolumeTask_snapshot(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.ReplaceRootVolumeTask
+	resourceName := "aws_ec2_replace_root_volume_task.test"
+	snapshotResourceName := "aws_ebs_snapshot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplaceRootVolumeTaskConfig_snapshot(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delete_replaced_root_volume", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", snapshotResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "task_state", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ReplaceRootVolumeTask_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.ReplaceRootVolumeTask
+	resourceName := "aws_ec2_replace_root_volume_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplaceRootVolumeTaskConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccReplaceRootVolumeTaskConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplaceRootVolumeTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplaceRootVolumeTaskExists(ctx context.Context, n string, v *ec2.ReplaceRootVolumeTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Replace Root Volume Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindReplaceRootVolumeTaskByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReplaceRootVolumeTaskConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccReplaceRootVolumeTaskConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccReplaceRootVolumeTaskConfig_base(rName), `
+resource "aws_ec2_replace_root_volume_task" "test" {
+  instance_id = aws_instance.test.id
+}
+`)
+}
+
+func testAccReplaceRootVolumeTaskConfig_snapshot(rName string) string {
+	return acctest.ConfigCompose(testAccReplaceRootVolumeTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_instance.test.root_block_device[0].volume_id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_replace_root_volume_task" "test" {
+  instance_id                 = aws_instance.test.id
+  snapshot_id                 = aws_ebs_snapshot.test.id
+  delete_replaced_root_volume = true
+}
+`, rName))
+}
+
+func testAccReplaceRootVolumeTaskConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccReplaceRootVolumeTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_replace_root_volume_task" "test" {
+  instance_id = aws_instance.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_ec2_spot_placement_scores")
+func DataSourceSpotPlacementScores() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSpotPlacementScoresRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"architecture_types": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"instance_requirements"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.ArchitectureType_Values(), false),
+				},
+			},
+			"instance_requirements": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Elem:         instanceRequirementsSchema(),
+				ExactlyOneOf: []string{"instance_requirements", "instance_types"},
+			},
+			"instance_types": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"instance_requirements", "instance_types"},
+			},
+			"region_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"single_availability_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"spot_placement_scores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 2000000000),
+			},
+			"target_capacity_unit_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TargetCapacityUnitType_Values(), false),
+			},
+			"virtualization_types": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"instance_requirements"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.VirtualizationType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotPlacementScoresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.GetSpotPlacementScoresInput{
+		SingleAvailabilityZone: aws.Bool(d.Get("single_availability_zone").(bool)),
+		TargetCapacity:         aws.Int64(int64(d.Get("target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("instance_requirements"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InstanceRequirementsWithMetadata = &ec2.InstanceRequirementsWithMetadataRequest{
+			ArchitectureTypes:    flex.ExpandStringSet(d.Get("architecture_types").(*schema.Set)),
+			InstanceRequirements: expandInstanceRequirementsRequest(v.([]interface{})[0].(map[string]interface{})),
+			VirtualizationTypes:  flex.ExpandStringSet(d.Get("virtualization_types").(*schema.Set)),
+		}
+	}
+
+	if v, ok := d.GetOk("instance_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("region_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.RegionNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("target_capacity_unit_type"); ok {
+		input.TargetCapacityUnitType = aws.String(v.(string))
+	}
+
+	output, err := FindSpotPlacementScores(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Placement Scores: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("spot_placement_scores", flattenSpotPlacementScores(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting spot_placement_scores: %s", err)
+	}
+
+	return diags
+}
+
+func flattenSpotPlacementScore(apiObject *ec2.SpotPlacementScore) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AvailabilityZoneId; v != nil {
+		tfMap["availability_zone_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["region"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Score; v != nil {
+		tfMap["score"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenSpotPlacementScores(apiObjects []*ec2.SpotPlacementScore) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenSpotPlacementScore(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2SpotPlacementScoresDataSource_instanceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_instanceTypes(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "spot_placement_scores.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.region"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotPlacementScoresDataSource_instanceRequirements(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_instanceRequirements(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "spot_placement_scores.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.availability_zone_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_instanceTypes() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_scores" "test" {
+  instance_types  = ["m5.large", "m5a.large", "m6i.large"]
+  region_names    = [data.aws_region.current.name]
+  target_capacity = 2
+}
+`
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_instanceRequirements() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_scores" "test" {
+  architecture_types        = ["x86_64"]
+  region_names              = [data.aws_region.current.name]
+  single_availability_zone  = true
+  target_capacity           = 8
+  target_capacity_unit_type = "vcpu"
+  virtualization_types      = ["hvm"]
+
+  instance_requirements {
+    memory_mib {
+      min = 4096
+    }
+
+    vcpu_count {
+      min = 2
+      max = 8
+    }
+  }
+}
+`
+}
@@ -13,116 +13,122 @@ import (
 )
 
 const (
-	errCodeAnalysisExistsForNetworkInsightsPath              = "AnalysisExistsForNetworkInsightsPath"
-	errCodeAuthFailure                                       = "AuthFailure"
-	errCodeClientInvalidHostIDNotFound                       = "Client.InvalidHostID.NotFound"
-	errCodeConcurrentMutationLimitExceeded                   = "ConcurrentMutationLimitExceeded"
-	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone      = "DefaultSubnetAlreadyExistsInAvailabilityZone"
-	errCodeDependencyViolation                               = "DependencyViolation"
-	errCodeGatewayNotAttached                                = "Gateway.NotAttached"
-	errCodeIncorrectState                                    = "IncorrectState"
-	errCodeInsufficientInstanceCapacity                      = "InsufficientInstanceCapacity"
-	errCodeInvalidAMIIDNotFound                              = "InvalidAMIID.NotFound"
-	errCodeInvalidAMIIDUnavailable                           = "InvalidAMIID.Unavailable"
-	errCodeInvalidAddressNotFound                            = "InvalidAddress.NotFound"
-	errCodeInvalidAllocationIDNotFound                       = "InvalidAllocationID.NotFound"
-	errCodeInvalidAssociationIDNotFound                      = "InvalidAssociationID.NotFound"
-	errCodeInvalidAttachmentIDNotFound                       = "InvalidAttachmentID.NotFound"
-	errCodeInvalidCapacityReservationIdNotFound              = "InvalidCapacityReservationId.NotFound'"
-	errCodeInvalidCarrierGatewayIDNotFound                   = "InvalidCarrierGatewayID.NotFound"
-	errCodeInvalidClientVPNActiveAssociationNotFound         = "InvalidClientVpnActiveAssociationNotFound"
-	errCodeInvalidClientVPNAssociationIdNotFound             = "InvalidClientVpnAssociationIdNotFound"
-	errCodeInvalidClientVPNAuthorizationRuleNotFound         = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
-	errCodeInvalidClientVPNEndpointIdNotFound                = "InvalidClientVpnEndpointId.NotFound"
-	errCodeInvalidClientVPNRouteNotFound                     = "InvalidClientVpnRouteNotFound"
-	errCodeInvalidConnectionNotification                     = "InvalidConnectionNotification"
-	errCodeInvalidConversionTaskIdMalformed                  = "InvalidConversionTaskId.Malformed"
-	errCodeInvalidCustomerGatewayIDNotFound                  = "InvalidCustomerGatewayID.NotFound"
-	errCodeInvalidDHCPOptionIDNotFound                       = "InvalidDhcpOptionID.NotFound"
-	errCodeInvalidFleetIdNotFound                            = "InvalidFleetId.NotFound"
-	errCodeInvalidFlowLogIdNotFound                          = "InvalidFlowLogId.NotFound"
-	errCodeInvalidGatewayIDNotFound                          = "InvalidGatewayID.NotFound"
-	errCodeInvalidGroupInUse                                 = "InvalidGroup.InUse"
-	errCodeInvalidGroupNotFound                              = "InvalidGroup.NotFound"
-	errCodeInvalidHostIDNotFound                             = "InvalidHostID.NotFound"
-	errCodeInvalidInstanceConnectEndpointIdNotFound          = "InvalidInstanceConnectEndpointId.NotFound"
-	errCodeInvalidInstanceID                                 = "InvalidInstanceID"
-	errCodeInvalidInstanceIDNotFound                         = "InvalidInstanceID.NotFound"
-	errCodeInvalidInternetGatewayIDNotFound                  = "InvalidInternetGatewayID.NotFound"
-	errCodeInvalidIPAMIdNotFound                             = "InvalidIpamId.NotFound"
-	errCodeInvalidIPAMPoolAllocationIdNotFound               = "InvalidIpamPoolAllocationId.NotFound"
-	errCodeInvalidIPAMPoolIdNotFound                         = "InvalidIpamPoolId.NotFound"
-	errCodeInvalidIPAMResourceDiscoveryIdNotFound            = "InvalidIpamResourceDiscoveryId.NotFound"
-	errCodeInvalidIPAMResourceDiscoveryAssociationIdNotFound = "InvalidIpamResourceDiscoveryAssociationId.NotFound"
-	errCodeInvalidIPAMScopeIdNotFound                        = "InvalidIpamScopeId.NotFound"
-	errCodeInvalidKeyPairNotFound                            = "InvalidKeyPair.NotFound"
-	errCodeInvalidLaunchTemplateIdMalformed                  = "InvalidLaunchTemplateId.Malformed"
-	errCodeInvalidLaunchTemplateIdNotFound                   = "InvalidLaunchTemplateId.NotFound"
-	errCodeInvalidLaunchTemplateIdVersionNotFound            = "InvalidLaunchTemplateId.VersionNotFound"
-	errCodeInvalidLaunchTemplateNameNotFoundException        = "InvalidLaunchTemplateName.NotFoundException"
-	errCodeInvalidNetworkACLEntryNotFound                    = "InvalidNetworkAclEntry.NotFound"
-	errCodeInvalidNetworkACLIDNotFound                       = "InvalidNetworkAclID.NotFound"
-	errCodeInvalidNetworkInterfaceIDNotFound                 = "InvalidNetworkInterfaceID.NotFound"
-	errCodeInvalidNetworkInsightsAnalysisIdNotFound          = "InvalidNetworkInsightsAnalysisId.NotFound"
-	errCodeInvalidNetworkInsightsPathIdNotFound              = "InvalidNetworkInsightsPathId.NotFound"
-	errCodeInvalidParameter                                  = "InvalidParameter"
-	errCodeInvalidParameterCombination                       = "InvalidParameterCombination"
-	errCodeInvalidParameterException                         = "InvalidParameterException"
-	errCodeInvalidParameterValue                             = "InvalidParameterValue"
-	errCodeInvalidPermissionDuplicate                        = "InvalidPermission.Duplicate"
-	errCodeInvalidPermissionNotFound                         = "InvalidPermission.NotFound"
-	errCodeInvalidPlacementGroupUnknown                      = "InvalidPlacementGroup.Unknown"
-	errCodeInvalidPoolIDNotFound                             = "InvalidPoolID.NotFound"
-	errCodeInvalidPrefixListIDNotFound                       = "InvalidPrefixListID.NotFound"
-	errCodeInvalidPrefixListIdNotFound                       = "InvalidPrefixListId.NotFound"
-	errCodeInvalidPublicIpv4PoolIDNotFound                   = "InvalidPublicIpv4PoolID.NotFound" // nosemgrep:ci.caps5-in-const-name,ci.caps5-in-var-name
-	errCodeInvalidRouteNotFound                              = "InvalidRoute.NotFound"
-	errCodeInvalidRouteTableIDNotFound                       = "InvalidRouteTableID.NotFound"
-	errCodeInvalidRouteTableIdNotFound                       = "InvalidRouteTableId.NotFound"
-	errCodeInvalidSecurityGroupIDNotFound                    = "InvalidSecurityGroupID.NotFound"
-	errCodeInvalidSecurityGroupRuleIdNotFound                = "InvalidSecurityGroupRuleId.NotFound"
-	errCodeInvalidServiceName                                = "InvalidServiceName"
-	errCodeInvalidSnapshotInUse                              = "InvalidSnapshot.InUse"
-	errCodeInvalidSnapshotNotFound                           = "InvalidSnapshot.NotFound"
-	ErrCodeInvalidSpotDatafeedNotFound                       = "InvalidSpotDatafeed.NotFound"
-	errCodeInvalidSpotFleetRequestConfig                     = "InvalidSpotFleetRequestConfig"
-	errCodeInvalidSpotFleetRequestIdNotFound                 = "InvalidSpotFleetRequestId.NotFound"
-	errCodeInvalidSpotInstanceRequestIDNotFound              = "InvalidSpotInstanceRequestID.NotFound"
-	errCodeInvalidSubnetCIDRReservationIDNotFound            = "InvalidSubnetCidrReservationID.NotFound"
-	errCodeInvalidSubnetIDNotFound                           = "InvalidSubnetID.NotFound"
-	errCodeInvalidSubnetIdNotFound                           = "InvalidSubnetId.NotFound"
-	errCodeInvalidTrafficMirrorFilterIdNotFound              = "InvalidTrafficMirrorFilterId.NotFound"
-	errCodeInvalidTrafficMirrorFilterRuleIdNotFound          = "InvalidTrafficMirrorFilterRuleId.NotFound"
-	errCodeInvalidTrafficMirrorSessionIdNotFound             = "InvalidTrafficMirrorSessionId.NotFound"
-	errCodeInvalidTrafficMirrorTargetIdNotFound              = "InvalidTrafficMirrorTargetId.NotFound"
-	errCodeInvalidTransitGatewayAttachmentIDNotFound         = "InvalidTransitGatewayAttachmentID.NotFound"
-	errCodeInvalidTransitGatewayConnectPeerIDNotFound        = "InvalidTransitGatewayConnectPeerID.NotFound"
-	errCodeInvalidTransitGatewayPolicyTableIdNotFound        = "InvalidTransitGatewayPolicyTableId.NotFound"
-	errCodeInvalidTransitGatewayIDNotFound                   = "InvalidTransitGatewayID.NotFound"
-	errCodeInvalidTransitGatewayMulticastDomainIdNotFound    = "InvalidTransitGatewayMulticastDomainId.NotFound"
-	errCodeInvalidVerifiedAccessEndpointIdNotFound           = "InvalidVerifiedAccessEndpointId.NotFound"
-	errCodeInvalidVerifiedAccessGroupIdNotFound              = "InvalidVerifiedAccessGroupId.NotFound"
-	errCodeInvalidVerifiedAccessInstanceIdNotFound           = "InvalidVerifiedAccessInstanceId.NotFound"
-	errCodeInvalidVerifiedAccessTrustProviderIdNotFound      = "InvalidVerifiedAccessTrustProviderId.NotFound"
-	errCodeInvalidVolumeNotFound                             = "InvalidVolume.NotFound"
-	errCodeInvalidVPCCIDRBlockAssociationIDNotFound          = "InvalidVpcCidrBlockAssociationID.NotFound"
-	errCodeInvalidVPCEndpointIdNotFound                      = "InvalidVpcEndpointId.NotFound"
-	errCodeInvalidVPCEndpointNotFound                        = "InvalidVpcEndpoint.NotFound"
-	errCodeInvalidVPCEndpointServiceIdNotFound               = "InvalidVpcEndpointServiceId.NotFound"
-	errCodeInvalidVPCIDNotFound                              = "InvalidVpcID.NotFound"
-	errCodeInvalidVPCPeeringConnectionIDNotFound             = "InvalidVpcPeeringConnectionID.NotFound"
-	errCodeInvalidVPNConnectionIDNotFound                    = "InvalidVpnConnectionID.NotFound"
-	errCodeInvalidVPNGatewayAttachmentNotFound               = "InvalidVpnGatewayAttachment.NotFound"
-	errCodeInvalidVPNGatewayIDNotFound                       = "InvalidVpnGatewayID.NotFound"
-	errCodeNatGatewayNotFound                                = "NatGatewayNotFound"
-	errCodeOperationNotPermitted                             = "OperationNotPermitted"
-	errCodePrefixListVersionMismatch                         = "PrefixListVersionMismatch"
-	errCodeResourceNotReady                                  = "ResourceNotReady"
-	errCodeSnapshotCreationPerVolumeRateExceeded             = "SnapshotCreationPerVolumeRateExceeded"
-	errCodeUnsupportedOperation                              = "UnsupportedOperation"
-	errCodeVolumeInUse                                       = "VolumeInUse"
-	errCodeVPNConnectionLimitExceeded                        = "VpnConnectionLimitExceeded"
-	errCodeVPNGatewayLimitExceeded                           = "VpnGatewayLimitExceeded"
+	errCodeAnalysisExistsForNetworkInsightsPath                                    = "AnalysisExistsForNetworkInsightsPath"
+	errCodeAuthFailure                                                             = "AuthFailure"
+	errCodeClientInvalidHostIDNotFound                                             = "Client.InvalidHostID.NotFound"
+	errCodeConcurrentMutationLimitExceeded                                         = "ConcurrentMutationLimitExceeded"
+	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone                            = "DefaultSubnetAlreadyExistsInAvailabilityZone"
+	errCodeDependencyViolation                                                     = "DependencyViolation"
+	errCodeGatewayNotAttached                                                      = "Gateway.NotAttached"
+	errCodeIncorrectState                                                          = "IncorrectState"
+	errCodeInsufficientInstanceCapacity                                            = "InsufficientInstanceCapacity"
+	errCodeInvalidAMIIDNotFound                                                    = "InvalidAMIID.NotFound"
+	errCodeInvalidAMIIDUnavailable                                                 = "InvalidAMIID.Unavailable"
+	errCodeInvalidAddressNotFound                                                  = "InvalidAddress.NotFound"
+	errCodeInvalidAllocationIDNotFound                                             = "InvalidAllocationID.NotFound"
+	errCodeInvalidAssociationIDNotFound                                            = "InvalidAssociationID.NotFound"
+	errCodeInvalidAttachmentIDNotFound                                             = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIdNotFound                               = "InvalidCapacityReservationFleetId.NotFound"
+	errCodeInvalidCapacityReservationIdNotFound                                    = "InvalidCapacityReservationId.NotFound'"
+	errCodeInvalidCarrierGatewayIDNotFound                                         = "InvalidCarrierGatewayID.NotFound"
+	errCodeInvalidClientVPNActiveAssociationNotFound                               = "InvalidClientVpnActiveAssociationNotFound"
+	errCodeInvalidClientVPNAssociationIdNotFound                                   = "InvalidClientVpnAssociationIdNotFound"
+	errCodeInvalidClientVPNAuthorizationRuleNotFound                               = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
+	errCodeInvalidClientVPNEndpointIdNotFound                                      = "InvalidClientVpnEndpointId.NotFound"
+	errCodeInvalidClientVPNRouteNotFound                                           = "InvalidClientVpnRouteNotFound"
+	errCodeInvalidConnectionNotification                                           = "InvalidConnectionNotification"
+	errCodeInvalidConversionTaskIdMalformed                                        = "InvalidConversionTaskId.Malformed"
+	errCodeInvalidCustomerGatewayIDNotFound                                        = "InvalidCustomerGatewayID.NotFound"
+	errCodeInvalidDHCPOptionIDNotFound                                             = "InvalidDhcpOptionID.NotFound"
+	errCodeInvalidFleetIdNotFound                                                  = "InvalidFleetId.NotFound"
+	errCodeInvalidFlowLogIdNotFound                                                = "InvalidFlowLogId.NotFound"
+	errCodeInvalidGatewayIDNotFound                                                = "InvalidGatewayID.NotFound"
+	errCodeInvalidGroupInUse                                                       = "InvalidGroup.InUse"
+	errCodeInvalidGroupNotFound                                                    = "InvalidGroup.NotFound"
+	errCodeInvalidHostIDNotFound                                                   = "InvalidHostID.NotFound"
+	errCodeInvalidInstanceConnectEndpointIdNotFound                                = "InvalidInstanceConnectEndpointId.NotFound"
+	errCodeInvalidInstanceEventWindowIdNotFound                                    = "InvalidInstanceEventWindowId.NotFound"
+	errCodeInvalidInstanceID                                                       = "InvalidInstanceID"
+	errCodeInvalidInstanceIDNotFound                                               = "InvalidInstanceID.NotFound"
+	errCodeInvalidInternetGatewayIDNotFound                                        = "InvalidInternetGatewayID.NotFound"
+	errCodeInvalidIPAMIdNotFound                                                   = "InvalidIpamId.NotFound"
+	errCodeInvalidIPAMPoolAllocationIdNotFound                                     = "InvalidIpamPoolAllocationId.NotFound"
+	errCodeInvalidIPAMPoolIdNotFound                                               = "InvalidIpamPoolId.NotFound"
+	errCodeInvalidIPAMResourceDiscoveryIdNotFound                                  = "InvalidIpamResourceDiscoveryId.NotFound"
+	errCodeInvalidIPAMResourceDiscoveryAssociationIdNotFound                       = "InvalidIpamResourceDiscoveryAssociationId.NotFound"
+	errCodeInvalidIPAMScopeIdNotFound                                              = "InvalidIpamScopeId.NotFound"
+	errCodeInvalidKeyPairNotFound                                                  = "InvalidKeyPair.NotFound"
+	errCodeInvalidLaunchTemplateIdMalformed                                        = "InvalidLaunchTemplateId.Malformed"
+	errCodeInvalidLaunchTemplateIdNotFound                                         = "InvalidLaunchTemplateId.NotFound"
+	errCodeInvalidLaunchTemplateIdVersionNotFound                                  = "InvalidLaunchTemplateId.VersionNotFound"
+	errCodeInvalidLaunchTemplateNameNotFoundException                              = "InvalidLaunchTemplateName.NotFoundException"
+	errCodeInvalidLocalGatewayRouteTableIDNotFound                                 = "InvalidLocalGatewayRouteTableID.NotFound"
+	errCodeInvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationIDNotFound = "InvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationID.NotFound"
+	errCodeInvalidNetworkACLEntryNotFound                                          = "InvalidNetworkAclEntry.NotFound"
+	errCodeInvalidNetworkACLIDNotFound                                             = "InvalidNetworkAclID.NotFound"
+	errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound                     = "InvalidNetworkInsightsAccessScopeAnalysisId.NotFound"
+	errCodeInvalidNetworkInsightsAccessScopeIdNotFound                             = "InvalidNetworkInsightsAccessScopeId.NotFound"
+	errCodeInvalidNetworkInterfaceIDNotFound                                       = "InvalidNetworkInterfaceID.NotFound"
+	errCodeInvalidNetworkInsightsAnalysisIdNotFound                                = "InvalidNetworkInsightsAnalysisId.NotFound"
+	errCodeInvalidNetworkInsightsPathIdNotFound                                    = "InvalidNetworkInsightsPathId.NotFound"
+	errCodeInvalidParameter                                                        = "InvalidParameter"
+	errCodeInvalidParameterCombination                                             = "InvalidParameterCombination"
+	errCodeInvalidParameterException                                               = "InvalidParameterException"
+	errCodeInvalidParameterValue                                                   = "InvalidParameterValue"
+	errCodeInvalidPermissionDuplicate                                              = "InvalidPermission.Duplicate"
+	errCodeInvalidPermissionNotFound                                               = "InvalidPermission.NotFound"
+	errCodeInvalidPlacementGroupUnknown                                            = "InvalidPlacementGroup.Unknown"
+	errCodeInvalidPoolIDNotFound                                                   = "InvalidPoolID.NotFound"
+	errCodeInvalidPrefixListIDNotFound                                             = "InvalidPrefixListID.NotFound"
+	errCodeInvalidPrefixListIdNotFound                                             = "InvalidPrefixListId.NotFound"
+	errCodeInvalidPublicIpv4PoolIDNotFound                                         = "InvalidPublicIpv4PoolID.NotFound" // nosemgrep:ci.caps5-in-const-name,ci.caps5-in-var-name
+	errCodeInvalidRouteNotFound                                                    = "InvalidRoute.NotFound"
+	errCodeInvalidRouteTableIDNotFound                                             = "InvalidRouteTableID.NotFound"
+	errCodeInvalidRouteTableIdNotFound                                             = "InvalidRouteTableId.NotFound"
+	errCodeInvalidSecurityGroupIDNotFound                                          = "InvalidSecurityGroupID.NotFound"
+	errCodeInvalidSecurityGroupRuleIdNotFound                                      = "InvalidSecurityGroupRuleId.NotFound"
+	errCodeInvalidServiceName                                                      = "InvalidServiceName"
+	errCodeInvalidSnapshotInUse                                                    = "InvalidSnapshot.InUse"
+	errCodeInvalidSnapshotNotFound                                                 = "InvalidSnapshot.NotFound"
+	ErrCodeInvalidSpotDatafeedNotFound                                             = "InvalidSpotDatafeed.NotFound"
+	errCodeInvalidSpotFleetRequestConfig                                           = "InvalidSpotFleetRequestConfig"
+	errCodeInvalidSpotFleetRequestIdNotFound                                       = "InvalidSpotFleetRequestId.NotFound"
+	errCodeInvalidSpotInstanceRequestIDNotFound                                    = "InvalidSpotInstanceRequestID.NotFound"
+	errCodeInvalidSubnetCIDRReservationIDNotFound                                  = "InvalidSubnetCidrReservationID.NotFound"
+	errCodeInvalidSubnetIDNotFound                                                 = "InvalidSubnetID.NotFound"
+	errCodeInvalidSubnetIdNotFound                                                 = "InvalidSubnetId.NotFound"
+	errCodeInvalidTrafficMirrorFilterIdNotFound                                    = "InvalidTrafficMirrorFilterId.NotFound"
+	errCodeInvalidTrafficMirrorFilterRuleIdNotFound                                = "InvalidTrafficMirrorFilterRuleId.NotFound"
+	errCodeInvalidTrafficMirrorSessionIdNotFound                                   = "InvalidTrafficMirrorSessionId.NotFound"
+	errCodeInvalidTrafficMirrorTargetIdNotFound                                    = "InvalidTrafficMirrorTargetId.NotFound"
+	errCodeInvalidTransitGatewayAttachmentIDNotFound                               = "InvalidTransitGatewayAttachmentID.NotFound"
+	errCodeInvalidTransitGatewayConnectPeerIDNotFound                              = "InvalidTransitGatewayConnectPeerID.NotFound"
+	errCodeInvalidTransitGatewayPolicyTableIdNotFound                              = "InvalidTransitGatewayPolicyTableId.NotFound"
+	errCodeInvalidTransitGatewayIDNotFound                                         = "InvalidTransitGatewayID.NotFound"
+	errCodeInvalidTransitGatewayMulticastDomainIdNotFound                          = "InvalidTransitGatewayMulticastDomainId.NotFound"
+	errCodeInvalidVerifiedAccessEndpointIdNotFound                                 = "InvalidVerifiedAccessEndpointId.NotFound"
+	errCodeInvalidVerifiedAccessGroupIdNotFound                                    = "InvalidVerifiedAccessGroupId.NotFound"
+	errCodeInvalidVerifiedAccessInstanceIdNotFound                                 = "InvalidVerifiedAccessInstanceId.NotFound"
+	errCodeInvalidVerifiedAccessTrustProviderIdNotFound                            = "InvalidVerifiedAccessTrustProviderId.NotFound"
+	errCodeInvalidVolumeNotFound                                                   = "InvalidVolume.NotFound"
+	errCodeInvalidVPCCIDRBlockAssociationIDNotFound                                = "InvalidVpcCidrBlockAssociationID.NotFound"
+	errCodeInvalidVPCEndpointIdNotFound                                            = "InvalidVpcEndpointId.NotFound"
+	errCodeInvalidVPCEndpointNotFound                                              = "InvalidVpcEndpoint.NotFound"
+	errCodeInvalidVPCEndpointServiceIdNotFound                                     = "InvalidVpcEndpointServiceId.NotFound"
+	errCodeInvalidVPCIDNotFound                                                    = "InvalidVpcID.NotFound"
+	errCodeInvalidVPCPeeringConnectionIDNotFound                                   = "InvalidVpcPeeringConnectionID.NotFound"
+	errCodeInvalidVPNConnectionIDNotFound                                          = "InvalidVpnConnectionID.NotFound"
+	errCodeInvalidVPNGatewayAttachmentNotFound                                     = "InvalidVpnGatewayAttachment.NotFound"
+	errCodeInvalidVPNGatewayIDNotFound                                             = "InvalidVpnGatewayID.NotFound"
+	errCodeNatGatewayNotFound                                                      = "NatGatewayNotFound"
+	errCodeOperationNotPermitted                                                   = "OperationNotPermitted"
+	errCodePrefixListVersionMismatch                                               = "PrefixListVersionMismatch"
+	errCodeResourceNotReady                                                        = "ResourceNotReady"
+	errCodeSnapshotCreationPerVolumeRateExceeded                                   = "SnapshotCreationPerVolumeRateExceeded"
+	errCodeUnsupportedOperation                                                    = "UnsupportedOperation"
+	errCodeVolumeInUse                                                             = "VolumeInUse"
+	errCodeVPNConnectionLimitExceeded                                              = "VpnConnectionLimitExceeded"
+	errCodeVPNGatewayLimitExceeded                                                 = "VpnGatewayLimitExceeded"
 )
 
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
@@ -188,3 +194,75 @@ func UnsuccessfulItemsError(apiObjects []*ec2.UnsuccessfulItem) error {
 
 	return errors.Join(errs...)
 }
+
+func CancelCapacityReservationFleetError(apiObject *ec2.FailedCapacityReservationFleetCancellationResult) error {
+	if apiObject == nil || apiObject.CancelCapacityReservationFleetError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.CancelCapacityReservationFleetError.Code), aws.StringValue(apiObject.CancelCapacityReservationFleetError.Message), nil)
+}
+
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if err := CancelCapacityReservationFleetError(apiObject); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func EnableFastSnapshotRestoreStateErrorItemError(apiObject *ec2.EnableFastSnapshotRestoreStateErrorItem) error {
+	if apiObject == nil || apiObject.Error == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Error.Code), aws.StringValue(apiObject.Error.Message), nil)
+}
+
+func EnableFastSnapshotRestoreItemsError(apiObjects []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if err := EnableFastSnapshotRestoreStateErrorItemError(v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s: %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func DisableFastSnapshotRestoreStateErrorItemError(apiObject *ec2.DisableFastSnapshotRestoreStateErrorItem) error {
+	if apiObject == nil || apiObject.Error == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Error.Code), aws.StringValue(apiObject.Error.Message), nil)
+}
+
+func DisableFastSnapshotRestoreItemsError(apiObjects []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if err := DisableFastSnapshotRestoreStateErrorItemError(v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s: %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -1330,6 +1330,13 @@ func FindLocalGatewayRouteTables(ctx context.Context, conn *ec2.EC2, input *ec2.
 		return !lastPage
 	})
 
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -7355,3 +7362,855 @@ func FindVerifiedAccessEndpointByID(ctx context.Context, conn *ec2_sdkv2.Client,
 
 	return output, nil
 }
+
+func FindNetworkInsightsAccessScope(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopesInput) (*ec2.NetworkInsightsAccessScope, error) {
+	output, err := FindNetworkInsightsAccessScopes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindNetworkInsightsAccessScopes(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopesInput) ([]*ec2.NetworkInsightsAccessScope, error) {
+	var output []*ec2.NetworkInsightsAccessScope
+
+	err := conn.DescribeNetworkInsightsAccessScopesPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkInsightsAccessScopesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInsightsAccessScopes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NetworkInsightsAccessScope, error) {
+	input := &ec2.DescribeNetworkInsightsAccessScopesInput{
+		NetworkInsightsAccessScopeIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindNetworkInsightsAccessScope(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.NetworkInsightsAccessScopeId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeContentByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NetworkInsightsAccessScopeContent, error) {
+	input := &ec2.GetNetworkInsightsAccessScopeContentInput{
+		NetworkInsightsAccessScopeId: aws.String(id),
+	}
+
+	output, err := conn.GetNetworkInsightsAccessScopeContentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkInsightsAccessScopeContent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NetworkInsightsAccessScopeContent, nil
+}
+
+func FindNetworkInsightsAccessScopeAnalysis(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopeAnalysesInput) (*ec2.NetworkInsightsAccessScopeAnalysis, error) {
+	output, err := FindNetworkInsightsAccessScopeAnalyses(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindNetworkInsightsAccessScopeAnalyses(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopeAnalysesInput) ([]*ec2.NetworkInsightsAccessScopeAnalysis, error) {
+	var output []*ec2.NetworkInsightsAccessScopeAnalysis
+
+	err := conn.DescribeNetworkInsightsAccessScopeAnalysesPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkInsightsAccessScopeAnalysesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInsightsAccessScopeAnalyses {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeAnalysisByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NetworkInsightsAccessScopeAnalysis, error) {
+	input := &ec2.DescribeNetworkInsightsAccessScopeAnalysesInput{
+		NetworkInsightsAccessScopeAnalysisIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindNetworkInsightsAccessScopeAnalysis(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.NetworkInsightsAccessScopeAnalysisId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeAnalysisFindingsByID(ctx context.Context, conn *ec2.EC2, id string) ([]*ec2.AccessScopeAnalysisFinding, error) {
+	input := &ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput{
+		NetworkInsightsAccessScopeAnalysisId: aws.String(id),
+	}
+	var output []*ec2.AccessScopeAnalysisFinding
+
+	err := conn.GetNetworkInsightsAccessScopeAnalysisFindingsPagesWithContext(ctx, input, func(page *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AnalysisFindings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindInstanceEventWindow(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInstanceEventWindowsInput) (*ec2.InstanceEventWindow, error) {
+	output, err := FindInstanceEventWindows(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindInstanceEventWindows(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInstanceEventWindowsInput) ([]*ec2.InstanceEventWindow, error) {
+	var output []*ec2.InstanceEventWindow
+
+	err := conn.DescribeInstanceEventWindowsPagesWithContext(ctx, input, func(page *ec2.DescribeInstanceEventWindowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InstanceEventWindows {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceEventWindowIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindInstanceEventWindowByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.InstanceEventWindow, error) {
+	input := &ec2.DescribeInstanceEventWindowsInput{
+		InstanceEventWindowIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindInstanceEventWindow(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.InstanceEventWindowStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.InstanceEventWindowId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindInstanceEventWindowAssociationTargetByID returns the association target of the specified instance event window.
+// Returns NotFoundError if the window has no association target.
+func FindInstanceEventWindowAssociationTargetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.InstanceEventWindowAssociationTarget, error) {
+	output, err := FindInstanceEventWindowByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	target := output.AssociationTarget
+
+	if target == nil || (len(target.DedicatedHostIds) == 0 && len(target.InstanceIds) == 0 && len(target.Tags) == 0) {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("EC2 Instance Event Window (%s) has no association target", id),
+		}
+	}
+
+	return target, nil
+}
+
+func FindCapacityReservationFleet(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) (*ec2.CapacityReservationFleet, error) {
+	output, err := FindCapacityReservationFleets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindCapacityReservationFleets(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) ([]*ec2.CapacityReservationFleet, error) {
+	var output []*ec2.CapacityReservationFleet
+
+	err := conn.DescribeCapacityReservationFleetsPagesWithContext(ctx, input, func(page *ec2.DescribeCapacityReservationFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservationFleets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindCapacityReservationFleetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.CapacityReservationFleet, error) {
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindCapacityReservationFleet(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.CapacityReservationFleetStateCancelled || state == ec2.CapacityReservationFleetStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.CapacityReservationFleetId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindFastSnapshotRestore(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastSnapshotRestoresInput) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	output, err := FindFastSnapshotRestores(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindFastSnapshotRestores(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastSnapshotRestoresInput) ([]*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	var output []*ec2.DescribeFastSnapshotRestoreSuccessItem
+
+	err := conn.DescribeFastSnapshotRestoresPagesWithContext(ctx, input, func(page *ec2.DescribeFastSnapshotRestoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FastSnapshotRestores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindFastSnapshotRestoreByTwoPartKey(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	input := &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"availability-zone": availabilityZone,
+			"snapshot-id":       snapshotID,
+		}),
+	}
+
+	output, err := FindFastSnapshotRestore(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.FastSnapshotRestoreStateCodeDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindFastLaunchImage(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastLaunchImagesInput) (*ec2.DescribeFastLaunchImagesSuccessItem, error) {
+	output, err := FindFastLaunchImages(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindFastLaunchImages(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastLaunchImagesInput) ([]*ec2.DescribeFastLaunchImagesSuccessItem, error) {
+	var output []*ec2.DescribeFastLaunchImagesSuccessItem
+
+	err := conn.DescribeFastLaunchImagesPagesWithContext(ctx, input, func(page *ec2.DescribeFastLaunchImagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FastLaunchImages {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindFastLaunchImageByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.DescribeFastLaunchImagesSuccessItem, error) {
+	input := &ec2.DescribeFastLaunchImagesInput{
+		ImageIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindFastLaunchImage(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.ImageId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindInstanceTypesFromInstanceRequirements(ctx context.Context, conn *ec2.EC2, input *ec2.GetInstanceTypesFromInstanceRequirementsInput) ([]*ec2.InstanceTypeInfoFromInstanceRequirements, error) {
+	var output []*ec2.InstanceTypeInfoFromInstanceRequirements
+
+	err := conn.GetInstanceTypesFromInstanceRequirementsPagesWithContext(ctx, input, func(page *ec2.GetInstanceTypesFromInstanceRequirementsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InstanceTypes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindSpotPlacementScores(ctx context.Context, conn *ec2.EC2, input *ec2.GetSpotPlacementScoresInput) ([]*ec2.SpotPlacementScore, error) {
+	var output []*ec2.SpotPlacementScore
+
+	err := conn.GetSpotPlacementScoresPagesWithContext(ctx, input, func(page *ec2.GetSpotPlacementScoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SpotPlacementScores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindInstanceMetadataDefaults(ctx context.Context, conn *ec2.EC2) (*ec2.InstanceMetadataDefaultsResponse, error) {
+	input := &ec2.GetInstanceMetadataDefaultsInput{}
+
+	output, err := conn.GetInstanceMetadataDefaultsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccountLevel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccountLevel, nil
+}
+
+func FindReplaceRootVolumeTask(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeReplaceRootVolumeTasksInput) (*ec2.ReplaceRootVolumeTask, error) {
+	output, err := FindReplaceRootVolumeTasks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindReplaceRootVolumeTasks(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeReplaceRootVolumeTasksInput) ([]*ec2.ReplaceRootVolumeTask, error) {
+	var output []*ec2.ReplaceRootVolumeTask
+
+	err := conn.DescribeReplaceRootVolumeTasksPagesWithContext(ctx, input, func(page *ec2.DescribeReplaceRootVolumeTasksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplaceRootVolumeTasks {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindReplaceRootVolumeTaskByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.ReplaceRootVolumeTask, error) {
+	input := &ec2.DescribeReplaceRootVolumeTasksInput{
+		ReplaceRootVolumeTaskIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindReplaceRootVolumeTask(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.ReplaceRootVolumeTaskId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindStoreImageTask(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeStoreImageTasksInput) (*ec2.StoreImageTaskResult, error) {
+	output, err := FindStoreImageTasks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindStoreImageTasks(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeStoreImageTasksInput) ([]*ec2.StoreImageTaskResult, error) {
+	var output []*ec2.StoreImageTaskResult
+
+	err := conn.DescribeStoreImageTasksPagesWithContext(ctx, input, func(page *ec2.DescribeStoreImageTasksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StoreImageTaskResults {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound, errCodeInvalidAMIIDUnavailable) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindStoreImageTaskByImageID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.StoreImageTaskResult, error) {
+	input := &ec2.DescribeStoreImageTasksInput{
+		ImageIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindStoreImageTask(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.AmiId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindLocalGatewayRouteTableByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTable, error) {
+	input := &ec2.DescribeLocalGatewayRouteTablesInput{
+		LocalGatewayRouteTableIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindLocalGatewayRouteTable(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == localGatewayRouteTableStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.LocalGatewayRouteTableId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindLocalGatewayRouteTableVirtualInterfaceGroupAssociation(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	output, err := FindLocalGatewayRouteTableVirtualInterfaceGroupAssociations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindLocalGatewayRouteTableVirtualInterfaceGroupAssociations(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput) ([]*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	var output []*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation
+
+	err := conn.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsPagesWithContext(ctx, input, func(page *ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocalGatewayRouteTableVirtualInterfaceGroupAssociations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	input := &ec2.DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociationsInput{
+		LocalGatewayRouteTableVirtualInterfaceGroupAssociationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindLocalGatewayRouteTableVirtualInterfaceGroupAssociation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociated {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.LocalGatewayRouteTableVirtualInterfaceGroupAssociationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindCOIPPoolByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.CoipPool, error) {
+	input := &ec2.DescribeCoipPoolsInput{
+		PoolIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindCOIPPool(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.PoolId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindCOIPCIDRByTwoPartKey(ctx context.Context, conn *ec2.EC2, poolID, cidr string) (*ec2.CoipCidr, error) {
+	pool, err := FindCOIPPoolByID(ctx, conn, poolID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range pool.PoolCidrs {
+		if aws.StringValue(v) == cidr {
+			return &ec2.CoipCidr{
+				Cidr:                     aws.String(cidr),
+				CoipPoolId:               pool.PoolId,
+				LocalGatewayRouteTableId: pool.LocalGatewayRouteTableId,
+			}, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
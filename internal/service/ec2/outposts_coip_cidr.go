@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ec2_coip_cidr", name="CoIP CIDR")
+func ResourceCOIPCIDR() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCOIPCIDRCreate,
+		ReadWithoutTimeout:   resourceCOIPCIDRRead,
+		DeleteWithoutTimeout: resourceCOIPCIDRDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			},
+			"coip_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCOIPCIDRCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	cidr := d.Get("cidr").(string)
+	poolID := d.Get("coip_pool_id").(string)
+	id := COIPCIDRCreateResourceID(poolID, cidr)
+	input := &ec2.CreateCoipCidrInput{
+		Cidr:       aws.String(cidr),
+		CoipPoolId: aws.String(poolID),
+	}
+
+	_, err := conn.CreateCoipCidrWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 CoIP CIDR (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCOIPCIDRRead(ctx, d, meta)...)
+}
+
+func resourceCOIPCIDRRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	poolID, cidr, err := COIPCIDRParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindCOIPCIDRByTwoPartKey(ctx, conn, poolID, cidr)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 CoIP CIDR %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 CoIP CIDR (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cidr", output.Cidr)
+	d.Set("coip_pool_id", output.CoipPoolId)
+	d.Set("local_gateway_route_table_id", output.LocalGatewayRouteTableId)
+
+	return diags
+}
+
+func resourceCOIPCIDRDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	poolID, cidr, err := COIPCIDRParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting EC2 CoIP CIDR: %s", d.Id())
+	_, err = conn.DeleteCoipCidrWithContext(ctx, &ec2.DeleteCoipCidrInput{
+		Cidr:       aws.String(cidr),
+		CoipPoolId: aws.String(poolID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPoolIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 CoIP CIDR (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const coipCIDRIDSeparator = ","
+
+func COIPCIDRCreateResourceID(poolID, cidr string) string {
+	parts := []string{poolID, cidr}
+	id := strings.Join(parts, coipCIDRIDSeparator)
+
+	return id
+}
+
+func COIPCIDRParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, coipCIDRIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected COIP_POOL_ID%[2]sCIDR", id, coipCIDRIDSeparator)
+}
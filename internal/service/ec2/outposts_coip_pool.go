@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_coip_pool", name="CoIP Pool")
+// @Tags(identifierAttribute="id")
+func ResourceCOIPPool() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCOIPPoolCreate,
+		ReadWithoutTimeout:   resourceCOIPPoolRead,
+		UpdateWithoutTimeout: resourceCOIPPoolUpdate,
+		DeleteWithoutTimeout: resourceCOIPPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pool_cidrs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceCOIPPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.CreateCoipPoolInput{
+		LocalGatewayRouteTableId: aws.String(d.Get("local_gateway_route_table_id").(string)),
+		TagSpecifications:        getTagSpecificationsIn(ctx, ec2.ResourceTypeCoipPool),
+	}
+
+	output, err := conn.CreateCoipPoolWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 CoIP Pool: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CoipPool.PoolId))
+
+	return append(diags, resourceCOIPPoolRead(ctx, d, meta)...)
+}
+
+func resourceCOIPPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	pool, err := FindCOIPPoolByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 CoIP Pool %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 CoIP Pool (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, pool.PoolArn)
+	d.Set("local_gateway_route_table_id", pool.LocalGatewayRouteTableId)
+	d.Set("pool_cidrs", aws.StringValueSlice(pool.PoolCidrs))
+
+	setTagsOut(ctx, pool.Tags)
+
+	return diags
+}
+
+func resourceCOIPPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceCOIPPoolRead(ctx, d, meta)...)
+}
+
+func resourceCOIPPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[INFO] Deleting EC2 CoIP Pool: %s", d.Id())
+	_, err := conn.DeleteCoipPoolWithContext(ctx, &ec2.DeleteCoipPoolInput{
+		CoipPoolId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPoolIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 CoIP Pool (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
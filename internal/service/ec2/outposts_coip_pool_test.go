@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2OutpostsCOIPPool_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_coip_pool.test"
+	cidrResourceName := "aws_ec2_coip_cidr.test"
+	routeTableResourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCOIPPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsCOIPPoolConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCOIPPoolExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_route_table_id", routeTableResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pool_cidrs"},
+			},
+			{
+				Config: testAccOutpostsCOIPPoolConfig_cidr(rName, "10.1.0.0/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCOIPCIDRExists(ctx, cidrResourceName),
+					resource.TestCheckResourceAttr(cidrResourceName, "cidr", "10.1.0.0/28"),
+					resource.TestCheckResourceAttrPair(cidrResourceName, "coip_pool_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(cidrResourceName, "local_gateway_route_table_id", routeTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      cidrResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsCOIPPool_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_coip_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCOIPPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsCOIPPoolConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCOIPPoolExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceCOIPPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCOIPPoolExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 CoIP Pool ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindCOIPPoolByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCOIPCIDRExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 CoIP CIDR ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		poolID, cidr, err := tfec2.COIPCIDRParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.FindCOIPCIDRByTwoPartKey(ctx, conn, poolID, cidr)
+
+		return err
+	}
+}
+
+func testAccCheckCOIPPoolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_coip_pool" {
+				continue
+			}
+
+			_, err := tfec2.FindCOIPPoolByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 CoIP Pool %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOutpostsCOIPPoolConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOutpostsLocalGatewayRouteTableConfig_basic(rName, "coip"), fmt.Sprintf(`
+resource "aws_ec2_coip_pool" "test" {
+  local_gateway_route_table_id = aws_ec2_local_gateway_route_table.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccOutpostsCOIPPoolConfig_cidr(rName, cidr string) string {
+	return acctest.ConfigCompose(testAccOutpostsCOIPPoolConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ec2_coip_cidr" "test" {
+  cidr         = %[1]q
+  coip_pool_id = aws_ec2_coip_pool.test.id
+}
+`, cidr))
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+	localGatewayDataSourceName := "data.aws_ec2_local_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_basic(rName, "direct-vpc-routing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexache.MustCompile(`local-gateway-route-table/lgw-rtb-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_id", localGatewayDataSourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "direct-vpc-routing"),
+					resource.TestCheckResourceAttrPair(resourceName, "outpost_arn", localGatewayDataSourceName, "outpost_arn"),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_coip(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_basic(rName, "coip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mode", "coip"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_basic(rName, "direct-vpc-routing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceLocalGatewayRouteTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLocalGatewayRouteTableExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Local Gateway Route Table ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindLocalGatewayRouteTableByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckLocalGatewayRouteTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_local_gateway_route_table" {
+				continue
+			}
+
+			_, err := tfec2.FindLocalGatewayRouteTableByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Local Gateway Route Table %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLocalGatewayRouteTableBaseConfig() string {
+	return `
+data "aws_outposts_outposts" "test" {}
+
+data "aws_ec2_local_gateway" "test" {
+  filter {
+    name   = "outpost-arn"
+    values = [tolist(data.aws_outposts_outposts.test.arns)[0]]
+  }
+}
+`
+}
+
+func testAccOutpostsLocalGatewayRouteTableConfig_basic(rName, mode string) string {
+	return acctest.ConfigCompose(testAccLocalGatewayRouteTableBaseConfig(), fmt.Sprintf(`
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = data.aws_ec2_local_gateway.test.id
+  mode             = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, mode))
+}
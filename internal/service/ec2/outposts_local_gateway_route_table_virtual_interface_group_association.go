@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_local_gateway_route_table_virtual_interface_group_association", name="Local Gateway Route Table Virtual Interface Group Association")
+// @Tags(identifierAttribute="id")
+func ResourceLocalGatewayRouteTableVirtualInterfaceGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreate,
+		ReadWithoutTimeout:   resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead,
+		UpdateWithoutTimeout: resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationUpdate,
+		DeleteWithoutTimeout: resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"local_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_route_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_gateway_virtual_interface_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput{
+		LocalGatewayRouteTableId:            aws.String(d.Get("local_gateway_route_table_id").(string)),
+		LocalGatewayVirtualInterfaceGroupId: aws.String(d.Get("local_gateway_virtual_interface_group_id").(string)),
+		TagSpecifications:                   getTagSpecificationsIn(ctx, ec2.ResourceTypeLocalGatewayRouteTableVirtualInterfaceGroupAssociation),
+	}
+
+	output, err := conn.CreateLocalGatewayRouteTableVirtualInterfaceGroupAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Local Gateway Route Table Virtual Interface Group Association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocalGatewayRouteTableVirtualInterfaceGroupAssociation.LocalGatewayRouteTableVirtualInterfaceGroupAssociationId))
+
+	if _, err := WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Local Gateway Route Table Virtual Interface Group Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead(ctx, d, meta)...)
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	association, err := FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Local Gateway Route Table Virtual Interface Group Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Local Gateway Route Table Virtual Interface Group Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("local_gateway_id", association.LocalGatewayId)
+	d.Set("local_gateway_route_table_arn", association.LocalGatewayRouteTableArn)
+	d.Set("local_gateway_route_table_id", association.LocalGatewayRouteTableId)
+	d.Set("local_gateway_virtual_interface_group_id", association.LocalGatewayVirtualInterfaceGroupId)
+
+	setTagsOut(ctx, association.Tags)
+
+	return diags
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationRead(ctx, d, meta)...)
+}
+
+func resourceLocalGatewayRouteTableVirtualInterfaceGroupAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[INFO] Deleting EC2 Local Gateway Route Table Virtual Interface Group Association: %s", d.Id())
+	_, err := conn.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationWithContext(ctx, &ec2.DeleteLocalGatewayRouteTableVirtualInterfaceGroupAssociationInput{
+		LocalGatewayRouteTableVirtualInterfaceGroupAssociationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableVirtualInterfaceGroupAssociationIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Local Gateway Route Table Virtual Interface Group Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Local Gateway Route Table Virtual Interface Group Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
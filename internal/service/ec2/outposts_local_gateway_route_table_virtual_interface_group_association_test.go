@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2OutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table_virtual_interface_group_association.test"
+	routeTableResourceName := "aws_ec2_local_gateway_route_table.test"
+	virtualInterfaceGroupDataSourceName := "data.aws_ec2_local_gateway_virtual_interface_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_id", routeTableResourceName, "local_gateway_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_route_table_arn", routeTableResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_route_table_id", routeTableResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_gateway_virtual_interface_group_id", virtualInterfaceGroupDataSourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table_virtual_interface_group_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceLocalGatewayRouteTableVirtualInterfaceGroupAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Local Gateway Route Table Virtual Interface Group Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckLocalGatewayRouteTableVirtualInterfaceGroupAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_local_gateway_route_table_virtual_interface_group_association" {
+				continue
+			}
+
+			_, err := tfec2.FindLocalGatewayRouteTableVirtualInterfaceGroupAssociationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Local Gateway Route Table Virtual Interface Group Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOutpostsLocalGatewayRouteTableVirtualInterfaceGroupAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOutpostsLocalGatewayRouteTableConfig_basic(rName, "direct-vpc-routing"), `
+data "aws_ec2_local_gateway_virtual_interface_group" "test" {
+  local_gateway_id = data.aws_ec2_local_gateway.test.id
+}
+
+resource "aws_ec2_local_gateway_route_table_virtual_interface_group_association" "test" {
+  local_gateway_route_table_id             = aws_ec2_local_gateway_route_table.test.id
+  local_gateway_virtual_interface_group_id = data.aws_ec2_local_gateway_virtual_interface_group.test.id
+}
+`)
+}
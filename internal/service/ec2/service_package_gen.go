@@ -100,6 +100,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEBSVolumes,
 			TypeName: "aws_ebs_volumes",
 		},
+		{
+			Factory:  DataSourceCapacityReservationFleet,
+			TypeName: "aws_ec2_capacity_reservation_fleet",
+		},
 		{
 			Factory:  DataSourceClientVPNEndpoint,
 			TypeName: "aws_ec2_client_vpn_endpoint",
@@ -132,6 +136,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceInstanceTypes,
 			TypeName: "aws_ec2_instance_types",
 		},
+		{
+			Factory:  DataSourceInstanceTypesFromInstanceRequirements,
+			TypeName: "aws_ec2_instance_types_from_instance_requirements",
+		},
 		{
 			Factory:  DataSourceLocalGateway,
 			TypeName: "aws_ec2_local_gateway",
@@ -188,6 +196,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSerialConsoleAccess,
 			TypeName: "aws_ec2_serial_console_access",
 		},
+		{
+			Factory:  DataSourceSpotPlacementScores,
+			TypeName: "aws_ec2_spot_placement_scores",
+		},
 		{
 			Factory:  DataSourceSpotPrice,
 			TypeName: "aws_ec2_spot_price",
@@ -398,6 +410,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "image/",
+			},
 		},
 		{
 			Factory:  ResourceAMICopy,
@@ -483,6 +499,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEBSEncryptionByDefault,
 			TypeName: "aws_ebs_encryption_by_default",
 		},
+		{
+			Factory:  ResourceEBSFastSnapshotRestore,
+			TypeName: "aws_ebs_fast_snapshot_restore",
+			Name:     "EBS Fast Snapshot Restore",
+		},
 		{
 			Factory:  ResourceEBSSnapshot,
 			TypeName: "aws_ebs_snapshot",
@@ -490,6 +511,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "snapshot/",
+			},
 		},
 		{
 			Factory:  ResourceEBSSnapshotCopy,
@@ -514,6 +539,20 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "volume/",
+			},
+		},
+		{
+			Factory:  ResourceAMIFastLaunch,
+			TypeName: "aws_ec2_ami_fast_launch",
+			Name:     "AMI Fast Launch",
+		},
+		{
+			Factory:  ResourceAMIStoreTask,
+			TypeName: "aws_ec2_ami_store_task",
+			Name:     "AMI Store Task",
 		},
 		{
 			Factory:  ResourceAvailabilityZoneGroup,
@@ -527,6 +566,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceCapacityReservationFleet,
+			TypeName: "aws_ec2_capacity_reservation_fleet",
+			Name:     "Capacity Reservation Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceCarrierGateway,
 			TypeName: "aws_ec2_carrier_gateway",
@@ -555,6 +602,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClientVPNRoute,
 			TypeName: "aws_ec2_client_vpn_route",
 		},
+		{
+			Factory:  ResourceCOIPCIDR,
+			TypeName: "aws_ec2_coip_cidr",
+			Name:     "CoIP CIDR",
+		},
+		{
+			Factory:  ResourceCOIPPool,
+			TypeName: "aws_ec2_coip_pool",
+			Name:     "CoIP Pool",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceFleet,
 			TypeName: "aws_ec2_fleet",
@@ -576,6 +636,24 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_image_block_public_access",
 			Name:     "Image Block Public Access",
 		},
+		{
+			Factory:  ResourceInstanceEventWindow,
+			TypeName: "aws_ec2_instance_event_window",
+			Name:     "Instance Event Window",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceInstanceEventWindowAssociation,
+			TypeName: "aws_ec2_instance_event_window_association",
+			Name:     "Instance Event Window Association",
+		},
+		{
+			Factory:  ResourceInstanceMetadataDefaults,
+			TypeName: "aws_ec2_instance_metadata_defaults",
+			Name:     "Instance Metadata Defaults",
+		},
 		{
 			Factory:  ResourceInstanceState,
 			TypeName: "aws_ec2_instance_state",
@@ -584,6 +662,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLocalGatewayRoute,
 			TypeName: "aws_ec2_local_gateway_route",
 		},
+		{
+			Factory:  ResourceLocalGatewayRouteTable,
+			TypeName: "aws_ec2_local_gateway_route_table",
+			Name:     "Local Gateway Route Table",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceLocalGatewayRouteTableVirtualInterfaceGroupAssociation,
+			TypeName: "aws_ec2_local_gateway_route_table_virtual_interface_group_association",
+			Name:     "Local Gateway Route Table Virtual Interface Group Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceLocalGatewayRouteTableVPCAssociation,
 			TypeName: "aws_ec2_local_gateway_route_table_vpc_association",
@@ -604,6 +698,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceManagedPrefixListEntry,
 			TypeName: "aws_ec2_managed_prefix_list_entry",
 		},
+		{
+			Factory:  ResourceNetworkInsightsAccessScope,
+			TypeName: "aws_ec2_network_insights_access_scope",
+			Name:     "Network Insights Access Scope",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceNetworkInsightsAccessScopeAnalysis,
+			TypeName: "aws_ec2_network_insights_access_scope_analysis",
+			Name:     "Network Insights Access Scope Analysis",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceNetworkInsightsAnalysis,
 			TypeName: "aws_ec2_network_insights_analysis",
@@ -620,6 +730,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceReplaceRootVolumeTask,
+			TypeName: "aws_ec2_replace_root_volume_task",
+			Name:     "Replace Root Volume Task",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceSerialConsoleAccess,
 			TypeName: "aws_ec2_serial_console_access",
@@ -667,6 +785,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "transit-gateway/",
+			},
 		},
 		{
 			Factory:  ResourceTransitGatewayConnect,
@@ -763,6 +885,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "transit-gateway-attachment/",
+			},
 		},
 		{
 			Factory:  ResourceTransitGatewayVPCAttachmentAccepter,
@@ -787,6 +913,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "elastic-ip/",
+			},
 		},
 		{
 			Factory:  ResourceEIPAssociation,
@@ -807,6 +937,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "instance/",
+			},
 		},
 		{
 			Factory:  ResourceInternetGateway,
@@ -815,6 +949,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "internet-gateway/",
+			},
 		},
 		{
 			Factory:  ResourceInternetGatewayAttachment,
@@ -835,6 +973,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "launch-template/",
+			},
 		},
 		{
 			Factory:  ResourceMainRouteTableAssociation,
@@ -847,6 +989,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "natgateway/",
+			},
 		},
 		{
 			Factory:  ResourceNetworkACL,
@@ -855,6 +1001,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "network-acl/",
+			},
 		},
 		{
 			Factory:  ResourceNetworkACLAssociation,
@@ -871,6 +1021,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "network-interface/",
+			},
 		},
 		{
 			Factory:  ResourceNetworkInterfaceAttachment,
@@ -899,6 +1053,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "route-table/",
+			},
 		},
 		{
 			Factory:  ResourceRouteTableAssociation,
@@ -911,6 +1069,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "security-group/",
+			},
 		},
 		{
 			Factory:  ResourceSecurityGroupRule,
@@ -947,6 +1109,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "subnet/",
+			},
 		},
 		{
 			Factory:  ResourceVerifiedAccessEndpoint,
@@ -1001,6 +1167,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "vpc/",
+			},
 		},
 		{
 			Factory:  ResourceVPCDHCPOptions,
@@ -1021,6 +1191,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "vpc-endpoint/",
+			},
 		},
 		{
 			Factory:  ResourceVPCEndpointConnectionAccepter,
@@ -1133,6 +1307,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ec2",
+				ResourcePrefix: "vpc-peering-connection/",
+			},
 		},
 		{
 			Factory:  ResourceVPCPeeringConnectionAccepter,
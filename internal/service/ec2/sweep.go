@@ -34,6 +34,11 @@ func RegisterSweepers() {
 		F:    sweepCapacityReservations,
 	})
 
+	resource.AddTestSweepers("aws_ec2_capacity_reservation_fleet", &resource.Sweeper{
+		Name: "aws_ec2_capacity_reservation_fleet",
+		F:    sweepCapacityReservationFleets,
+	})
+
 	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
@@ -454,6 +459,55 @@ func sweepCapacityReservations(region string) error {
 	return nil
 }
 
+func sweepCapacityReservationFleets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.EC2Conn(ctx)
+	input := &ec2.DescribeCapacityReservationFleetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeCapacityReservationFleetsPagesWithContext(ctx, input, func(page *ec2.DescribeCapacityReservationFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservationFleets {
+			switch aws.StringValue(v.State) {
+			case ec2.CapacityReservationFleetStateCancelled, ec2.CapacityReservationFleetStateCancelling, ec2.CapacityReservationFleetStateExpired, ec2.CapacityReservationFleetStateExpiring:
+				continue
+			}
+
+			r := ResourceCapacityReservationFleet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CapacityReservationFleetId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Capacity Reservation Fleet sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Capacity Reservation Fleets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Capacity Reservation Fleets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepCarrierGateways(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
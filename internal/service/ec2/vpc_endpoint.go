@@ -37,6 +37,7 @@ const (
 
 // @SDKResource("aws_vpc_endpoint", name="VPC Endpoint")
 // @Tags(identifierAttribute="id")
+// @ARNImport(service="ec2", resourcePrefix="vpc-endpoint/")
 func ResourceVPCEndpoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCEndpointCreate,
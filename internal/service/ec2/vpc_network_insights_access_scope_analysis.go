@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_network_insights_access_scope_analysis", name="Network Insights Access Scope Analysis")
+// @Tags(identifierAttribute="id")
+func ResourceNetworkInsightsAccessScopeAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisCreate,
+		ReadWithoutTimeout:   resourceNetworkInsightsAccessScopeAnalysisRead,
+		UpdateWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisUpdate,
+		DeleteWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzed_eni_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fail_on_findings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"findings_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"findings_found": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_insights_access_scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.StartNetworkInsightsAccessScopeAnalysisInput{
+		NetworkInsightsAccessScopeId: aws.String(d.Get("network_insights_access_scope_id").(string)),
+		TagSpecifications:            getTagSpecificationsIn(ctx, ec2.ResourceTypeNetworkInsightsAccessScopeAnalysis),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Access Scope Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAccessScopeAnalysisWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Network Insights Access Scope Analysis: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAccessScopeAnalysis.NetworkInsightsAccessScopeAnalysisId))
+
+	// Findings are only available once the analysis has completed.
+	failOnFindings := d.Get("fail_on_findings").(bool)
+
+	if d.Get("wait_for_completion").(bool) || failOnFindings {
+		if _, err := WaitNetworkInsightsAccessScopeAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Insights Access Scope Analysis (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	diags = append(diags, resourceNetworkInsightsAccessScopeAnalysisRead(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// The analysis is kept in state, and so is tainted, so that the next apply runs a new analysis.
+	if v := d.Get("findings_count").(int); failOnFindings && v > 0 {
+		return sdkdiag.AppendErrorf(diags, "EC2 Network Insights Access Scope Analysis (%s) has %d findings", d.Id(), v)
+	}
+
+	return diags
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Access Scope Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Insights Access Scope Analysis (%s): %s", d.Id(), err)
+	}
+
+	// Findings are only available once the analysis has completed.
+	var findingsCount int
+	if aws.StringValue(output.Status) == ec2.AnalysisStatusSucceeded {
+		findings, err := FindNetworkInsightsAccessScopeAnalysisFindingsByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Network Insights Access Scope Analysis (%s) findings: %s", d.Id(), err)
+		}
+
+		findingsCount = len(findings)
+	}
+
+	d.Set("analyzed_eni_count", output.AnalyzedEniCount)
+	d.Set("arn", output.NetworkInsightsAccessScopeAnalysisArn)
+	if output.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(output.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("findings_count", findingsCount)
+	d.Set("findings_found", output.FindingsFound)
+	d.Set("network_insights_access_scope_id", output.NetworkInsightsAccessScopeId)
+	d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceNetworkInsightsAccessScopeAnalysisRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Access Scope Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAccessScopeAnalysisWithContext(ctx, &ec2.DeleteNetworkInsightsAccessScopeAnalysisInput{
+		NetworkInsightsAccessScopeAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Network Insights Access Scope Analysis (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_subnet", name="Subnet")
 // @Tags(identifierAttribute="id")
+// @ARNImport(service="ec2", resourcePrefix="subnet/")
 func ResourceSubnet() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -3160,3 +3160,317 @@ func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Clie
 
 	return nil, err
 }
+
+func WaitNetworkInsightsAccessScopeAnalysisCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NetworkInsightsAccessScopeAnalysis, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ec2.AnalysisStatusRunning},
+		Target:     []string{ec2.AnalysisStatusSucceeded},
+		Timeout:    timeout,
+		Refresh:    StatusNetworkInsightsAccessScopeAnalysis(ctx, conn, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.NetworkInsightsAccessScopeAnalysis); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	InstanceEventWindowCreatedTimeout = 5 * time.Minute
+	InstanceEventWindowDeletedTimeout = 5 * time.Minute
+)
+
+func WaitInstanceEventWindowCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.InstanceEventWindow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.InstanceEventWindowStateCreating},
+		Target:  []string{ec2.InstanceEventWindowStateActive},
+		Refresh: StatusInstanceEventWindowState(ctx, conn, id),
+		Timeout: InstanceEventWindowCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.InstanceEventWindow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInstanceEventWindowDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.InstanceEventWindow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.InstanceEventWindowStateDeleting},
+		Target:  []string{},
+		Refresh: StatusInstanceEventWindowState(ctx, conn, id),
+		Timeout: InstanceEventWindowDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.InstanceEventWindow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			ec2.CapacityReservationFleetStateActive,
+			ec2.CapacityReservationFleetStateCancelling,
+			ec2.CapacityReservationFleetStateModifying,
+			ec2.CapacityReservationFleetStatePartiallyFulfilled,
+		},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastSnapshotRestoreEnabled(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: StatusFastSnapshotRestoreState(ctx, conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastSnapshotRestoreDisabled(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeDisabling, ec2.FastSnapshotRestoreStateCodeEnabled, ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{},
+		Refresh: StatusFastSnapshotRestoreState(ctx, conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastLaunchImageEnabled(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.DescribeFastLaunchImagesSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastLaunchStateCodeEnabling},
+		Target:  []string{ec2.FastLaunchStateCodeEnabled},
+		Refresh: StatusFastLaunchImageState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastLaunchImagesSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitFastLaunchImageDisabled(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.DescribeFastLaunchImagesSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.FastLaunchStateCodeDisabling, ec2.FastLaunchStateCodeEnabled},
+		Target:  []string{},
+		Refresh: StatusFastLaunchImageState(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastLaunchImagesSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitReplaceRootVolumeTaskSucceeded(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ReplaceRootVolumeTask, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ec2.ReplaceRootVolumeTaskStatePending, ec2.ReplaceRootVolumeTaskStateInProgress},
+		Target:     []string{ec2.ReplaceRootVolumeTaskStateSucceeded},
+		Refresh:    StatusReplaceRootVolumeTaskState(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.ReplaceRootVolumeTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitStoreImageTaskCompleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.StoreImageTaskResult, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{storeImageTaskStateInProgress},
+		Target:     []string{storeImageTaskStateCompleted},
+		Refresh:    StatusStoreImageTaskState(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.StoreImageTaskResult); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StoreTaskFailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.LocalGatewayRouteTable, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{localGatewayRouteTableStatePending},
+		Target:  []string{localGatewayRouteTableStateAvailable},
+		Refresh: StatusLocalGatewayRouteTableState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTable); ok {
+		if stateReason := output.StateReason; stateReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.LocalGatewayRouteTable, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{localGatewayRouteTableStateDeleting},
+		Target:  []string{},
+		Refresh: StatusLocalGatewayRouteTableState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTable); ok {
+		if stateReason := output.StateReason; stateReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{localGatewayRouteTableVirtualInterfaceGroupAssociationStatePending},
+		Target:  []string{localGatewayRouteTableVirtualInterfaceGroupAssociationStateAssociated},
+		Refresh: StatusLocalGatewayRouteTableVirtualInterfaceGroupAssociationState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableVirtualInterfaceGroupAssociationDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{localGatewayRouteTableVirtualInterfaceGroupAssociationStateDisassociating},
+		Target:  []string{},
+		Refresh: StatusLocalGatewayRouteTableVirtualInterfaceGroupAssociationState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVirtualInterfaceGroupAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
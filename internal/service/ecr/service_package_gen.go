@@ -80,6 +80,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "ecr",
+				ResourcePrefix: "repository/",
+			},
 		},
 		{
 			Factory:  ResourceRepositoryPolicy,
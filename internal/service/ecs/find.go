@@ -157,3 +157,30 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+func findTasks(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeTasksInput) ([]*ecs.Task, error) {
+	output, err := conn.DescribeTasksWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// When an ECS Task is not found by DescribeTasks(), it will return a Failure struct with Reason = "MISSING"
+	for _, v := range output.Failures {
+		if aws.StringValue(v.Reason) == "MISSING" {
+			return nil, &retry.NotFoundError{
+				LastRequest: input,
+			}
+		}
+	}
+
+	if len(output.Tasks) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Tasks, nil
+}
@@ -94,6 +94,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceTaskRun,
+			TypeName: "aws_ecs_task_run",
+			Name:     "Task Run",
+		},
 		{
 			Factory:  ResourceTaskSet,
 			TypeName: "aws_ecs_task_set",
@@ -281,9 +281,33 @@ func dataSourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, me
 	taskDefinition := d.Get("task_definition").(string)
 	d.SetId(strings.Join([]string{cluster, taskDefinition}, ","))
 
-	input := ecs.RunTaskInput{
-		Cluster:        aws.String(cluster),
-		TaskDefinition: aws.String(taskDefinition),
+	input, err := expandRunTaskInput(ctx, d, meta)
+	if err != nil {
+		return create.AppendDiagError(diags, names.ECS, create.ErrActionCreating, DSNameTaskExecution, d.Id(), err)
+	}
+
+	out, err := conn.RunTaskWithContext(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.ECS, create.ErrActionCreating, DSNameTaskExecution, d.Id(), err)
+	}
+	if out == nil || len(out.Tasks) == 0 {
+		return create.AppendDiagError(diags, names.ECS, create.ErrActionCreating, DSNameTaskExecution, d.Id(), tfresource.NewEmptyResultError(input))
+	}
+
+	var taskArns []*string
+	for _, t := range out.Tasks {
+		taskArns = append(taskArns, t.TaskArn)
+	}
+	d.Set("task_arns", flex.FlattenStringList(taskArns))
+
+	return diags
+}
+
+// expandRunTaskInput returns the RunTask input for the arguments shared by the aws_ecs_task_execution data source and the aws_ecs_task_run resource.
+func expandRunTaskInput(ctx context.Context, d *schema.ResourceData, meta interface{}) (*ecs.RunTaskInput, error) {
+	input := &ecs.RunTaskInput{
+		Cluster:        aws.String(d.Get("cluster").(string)),
+		TaskDefinition: aws.String(d.Get("task_definition").(string)),
 	}
 
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -319,14 +343,14 @@ func dataSourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, me
 	if v, ok := d.GetOk("placement_constraints"); ok {
 		pc, err := expandPlacementConstraints(v.(*schema.Set).List())
 		if err != nil {
-			return create.AppendDiagError(diags, names.ECS, create.ErrActionCreating, DSNameTaskExecution, d.Id(), err)
+			return nil, err
 		}
 		input.PlacementConstraints = pc
 	}
 	if v, ok := d.GetOk("placement_strategy"); ok {
 		ps, err := expandPlacementStrategy(v.([]interface{}))
 		if err != nil {
-			return create.AppendDiagError(diags, names.ECS, create.ErrActionCreating, DSNameTaskExecution, d.Id(), err)
+			return nil, err
 		}
 		input.PlacementStrategy = ps
 	}
@@ -343,21 +367,7 @@ func dataSourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, me
 		input.StartedBy = aws.String(v.(string))
 	}
 
-	out, err := conn.RunTaskWithContext(ctx, &input)
-	if err != nil {
-		return create.AppendDiagError(diags, names.ECS, create.ErrActionCreating, DSNameTaskExecution, d.Id(), err)
-	}
-	if out == nil || len(out.Tasks) == 0 {
-		return create.AppendDiagError(diags, names.ECS, create.ErrActionCreating, DSNameTaskExecution, d.Id(), tfresource.NewEmptyResultError(input))
-	}
-
-	var taskArns []*string
-	for _, t := range out.Tasks {
-		taskArns = append(taskArns, t.TaskArn)
-	}
-	d.Set("task_arns", flex.FlattenStringList(taskArns))
-
-	return diags
+	return input, nil
 }
 
 func expandTaskOverride(tfList []interface{}) *ecs.TaskOverride {
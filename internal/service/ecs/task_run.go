@@ -0,0 +1,544 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKResource("aws_ecs_task_run", name="Task Run")
+func ResourceTaskRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTaskRunCreate,
+		ReadWithoutTimeout:   resourceTaskRunRead,
+		DeleteWithoutTimeout: resourceTaskRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_provider_strategy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"desired_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"enable_ecs_managed_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enable_execute_command": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"launch_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"log_tail_lines": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_overrides": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"cpu": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"environment": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"memory": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"memory_reservation": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"resource_requirements": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(ecs.ResourceType_Values(), false),
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"cpu": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"execution_role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"inference_accelerator_overrides": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"device_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"memory": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"task_role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"placement_constraints": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expression": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ecs.PlacementConstraintType_Values(), false),
+						},
+					},
+				},
+			},
+			"placement_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"propagate_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
+			},
+			"reference_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"started_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tftags.TagsSchemaForceNew(),
+			"task_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"task_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTaskRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	input, err := expandRunTaskInput(ctx, d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	cluster, taskDefinition := d.Get("cluster").(string), d.Get("task_definition").(string)
+	output, err := conn.RunTaskWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "running ECS Task (%s): %s", taskDefinition, err)
+	}
+
+	var taskARNs []string
+	for _, v := range output.Tasks {
+		taskARNs = append(taskARNs, aws.StringValue(v.TaskArn))
+	}
+
+	// Tasks that were placed are recorded before any placement failures are reported,
+	// so that they are visible in state and the resource is tainted.
+	if len(taskARNs) > 0 {
+		d.SetId(id.UniqueId())
+		d.Set("task_arns", taskARNs)
+	}
+
+	if err := taskFailuresError(output.Failures); err != nil {
+		return sdkdiag.AppendErrorf(diags, "running ECS Task (%s): %s", taskDefinition, err)
+	}
+
+	tasks, err := waitTasksStopped(ctx, conn, cluster, taskARNs, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Task (%s) run: %s", taskDefinition, err)
+	}
+
+	// A failed run leaves the resource tainted, so it is run again on the next apply.
+	if err := tasksExitError(ctx, conn, meta.(*conns.AWSClient).LogsClient(ctx), tasks, d.Get("log_tail_lines").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "running ECS Task (%s): %s", taskDefinition, err)
+	}
+
+	return append(diags, resourceTaskRunRead(ctx, d, meta)...)
+}
+
+func resourceTaskRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Stopped tasks are only retained by ECS for a short time, so there is nothing to refresh.
+
+	return diags
+}
+
+func resourceTaskRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] ECS Task Run (%s) \"deleted\" by removing from state", d.Id())
+
+	return diags
+}
+
+func taskFailuresError(apiObjects []*ecs.Failure) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		err := fmt.Errorf("%s: %s", aws.StringValue(apiObject.Arn), aws.StringValue(apiObject.Reason))
+
+		if v := aws.StringValue(apiObject.Detail); v != "" {
+			err = fmt.Errorf("%w: %s", err, v)
+		}
+
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// tasksExitError returns an error describing each essential container of the specified stopped tasks that did not exit with code 0.
+// If logTailLines is positive, the last lines of each such container's CloudWatch Logs stream are included.
+func tasksExitError(ctx context.Context, conn *ecs.ECS, logsConn *cloudwatchlogs.Client, tasks []*ecs.Task, logTailLines int) error {
+	var errs []error
+	containerDefinitions := make(map[string]map[string]*ecs.ContainerDefinition)
+
+	for _, task := range tasks {
+		taskDefinitionARN := aws.StringValue(task.TaskDefinitionArn)
+
+		if _, ok := containerDefinitions[taskDefinitionARN]; !ok {
+			output, err := conn.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+				TaskDefinition: aws.String(taskDefinitionARN),
+			})
+
+			if err != nil {
+				return fmt.Errorf("reading ECS Task Definition (%s): %w", taskDefinitionARN, err)
+			}
+
+			m := make(map[string]*ecs.ContainerDefinition)
+			for _, v := range output.TaskDefinition.ContainerDefinitions {
+				m[aws.StringValue(v.Name)] = v
+			}
+			containerDefinitions[taskDefinitionARN] = m
+		}
+
+		taskARN := aws.StringValue(task.TaskArn)
+
+		for _, container := range task.Containers {
+			name := aws.StringValue(container.Name)
+			containerDefinition := containerDefinitions[taskDefinitionARN][name]
+
+			// Containers are essential unless marked otherwise.
+			if containerDefinition != nil && containerDefinition.Essential != nil && !aws.BoolValue(containerDefinition.Essential) {
+				continue
+			}
+
+			if container.ExitCode != nil && aws.Int64Value(container.ExitCode) == 0 {
+				continue
+			}
+
+			var msg strings.Builder
+			fmt.Fprintf(&msg, "task (%s) container (%s)", taskARN, name)
+			if container.ExitCode != nil {
+				fmt.Fprintf(&msg, " exited with code %d", aws.Int64Value(container.ExitCode))
+			} else {
+				msg.WriteString(" did not run")
+			}
+			if v := aws.StringValue(container.Reason); v != "" {
+				fmt.Fprintf(&msg, ": %s", v)
+			}
+			if v := aws.StringValue(task.StoppedReason); v != "" {
+				fmt.Fprintf(&msg, " (task stopped: %s)", v)
+			}
+
+			if logTailLines > 0 && containerDefinition != nil {
+				if lines, err := containerLogTail(ctx, logsConn, taskARN, containerDefinition, logTailLines); err != nil {
+					log.Printf("[WARN] reading CloudWatch Logs for ECS Task (%s) container (%s): %s", taskARN, name, err)
+				} else if len(lines) > 0 {
+					fmt.Fprintf(&msg, "\n\nLast %d log lines:\n%s", len(lines), strings.Join(lines, "\n"))
+				}
+			}
+
+			errs = append(errs, errors.New(msg.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// containerLogTail returns the last lines written by a container that uses the awslogs log driver.
+// The container's log stream can only be determined if an awslogs-stream-prefix is configured.
+func containerLogTail(ctx context.Context, conn *cloudwatchlogs.Client, taskARN string, containerDefinition *ecs.ContainerDefinition, n int) ([]string, error) {
+	logConfiguration := containerDefinition.LogConfiguration
+	if logConfiguration == nil || aws.StringValue(logConfiguration.LogDriver) != ecs.LogDriverAwslogs {
+		return nil, nil
+	}
+
+	group := aws.StringValue(logConfiguration.Options["awslogs-group"])
+	prefix := aws.StringValue(logConfiguration.Options["awslogs-stream-prefix"])
+	if group == "" || prefix == "" {
+		return nil, nil
+	}
+
+	taskID, err := taskIDFromARN(taskARN)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudwatchlogs.GetLogEventsInput{
+		Limit:         aws_sdkv2.Int32(int32(n)),
+		LogGroupName:  aws_sdkv2.String(group),
+		LogStreamName: aws_sdkv2.String(strings.Join([]string{prefix, aws.StringValue(containerDefinition.Name), taskID}, "/")),
+		StartFromHead: aws_sdkv2.Bool(false),
+	}
+
+	optFns := []func(*cloudwatchlogs.Options){}
+	if v := aws.StringValue(logConfiguration.Options["awslogs-region"]); v != "" {
+		optFns = append(optFns, func(o *cloudwatchlogs.Options) {
+			o.Region = v
+		})
+	}
+
+	output, err := conn.GetLogEvents(ctx, input, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, v := range output.Events {
+		lines = append(lines, aws_sdkv2.ToString(v.Message))
+	}
+
+	return lines, nil
+}
+
+// taskIDFromARN returns the ID of the task with the specified ARN, e.g. "arn:aws:ecs:us-west-2:123456789012:task/cluster/0123456789abcdef0123456789abcdef".
+func taskIDFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	return parts[len(parts)-1], nil
+}
@@ -157,3 +157,31 @@ func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service,
 
 	return err
 }
+
+// waitTasksStopped waits for all the specified ECS Tasks to reach the status "STOPPED".
+func waitTasksStopped(ctx context.Context, conn *ecs.ECS, cluster string, arns []string, timeout time.Duration) ([]*ecs.Task, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			taskStatusActivating,
+			taskStatusDeactivating,
+			taskStatusDeprovisioning,
+			taskStatusPending,
+			taskStatusProvisioning,
+			taskStatusRunning,
+			taskStatusStopping,
+		},
+		Target:     []string{taskStatusStopped},
+		Refresh:    statusTasks(ctx, conn, cluster, arns),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.([]*ecs.Task); ok {
+		return v, err
+	}
+
+	return nil, err
+}
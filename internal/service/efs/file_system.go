@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_efs_file_system", name="File System")
 // @Tags(identifierAttribute="id")
+// @ARNImport(service="elasticfilesystem", resourcePrefix="file-system/")
 func ResourceFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemCreate,
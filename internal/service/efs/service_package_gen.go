@@ -65,6 +65,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "elasticfilesystem",
+				ResourcePrefix: "file-system/",
+			},
 		},
 		{
 			Factory:  ResourceFileSystemPolicy,
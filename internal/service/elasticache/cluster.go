@@ -44,6 +44,7 @@ const (
 
 // @SDKResource("aws_elasticache_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="elasticache", resourcePrefix="cluster:")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
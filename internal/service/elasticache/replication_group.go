@@ -33,6 +33,7 @@ import (
 
 // @SDKResource("aws_elasticache_replication_group", name="Replication Group")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="elasticache", resourcePrefix="replicationgroup:")
 func ResourceReplicationGroup() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
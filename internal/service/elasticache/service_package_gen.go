@@ -53,6 +53,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "elasticache",
+				ResourcePrefix: "cluster:",
+			},
 		},
 		{
 			Factory:  ResourceGlobalReplicationGroup,
@@ -73,6 +77,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "elasticache",
+				ResourcePrefix: "replicationgroup:",
+			},
 		},
 		{
 			Factory:  ResourceSubnetGroup,
@@ -81,6 +89,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "elasticache",
+				ResourcePrefix: "subnetgroup:",
+			},
 		},
 		{
 			Factory:  ResourceUser,
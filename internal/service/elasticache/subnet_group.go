@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_elasticache_subnet_group", name="Subnet Group")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="elasticache", resourcePrefix="subnetgroup:")
 func ResourceSubnetGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSubnetGroupCreate,
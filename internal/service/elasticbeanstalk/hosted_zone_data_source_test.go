@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfelasticbeanstalk "github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
 )
 
 func TestAccElasticBeanstalkHostedZoneDataSource_basic(t *testing.T) {
@@ -65,10 +65,10 @@ func TestAccElasticBeanstalkHostedZoneDataSource_region(t *testing.T) {
 
 func testAccCheckHostedZone(resourceName string, region string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		expectedValue, ok := tfelasticbeanstalk.HostedZoneIDs[region]
+		expectedValue, err := staticdata.HostedZoneID(staticdata.ServiceElasticBeanstalk, region)
 
-		if !ok {
-			return fmt.Errorf("Unsupported region: %s", region)
+		if err != nil {
+			return err
 		}
 
 		return resource.TestCheckResourceAttr(resourceName, "id", expectedValue)(s)
@@ -9,12 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfelb "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
 )
 
 func TestAccELBServiceAccountDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	expectedAccountID := tfelb.AccountIdPerRegionMap[acctest.Region()]
+	expectedAccountID, _ := staticdata.AccountID(staticdata.ServiceELB, acctest.Region())
 
 	dataSourceName := "data.aws_elb_service_account.main"
 
@@ -36,7 +36,7 @@ func TestAccELBServiceAccountDataSource_basic(t *testing.T) {
 
 func TestAccELBServiceAccountDataSource_region(t *testing.T) {
 	ctx := acctest.Context(t)
-	expectedAccountID := tfelb.AccountIdPerRegionMap[acctest.Region()]
+	expectedAccountID, _ := staticdata.AccountID(staticdata.ServiceELB, acctest.Region())
 
 	dataSourceName := "data.aws_elb_service_account.regional"
 
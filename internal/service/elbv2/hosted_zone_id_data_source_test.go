@@ -9,11 +9,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
 )
 
 func TestAccELBV2HostedZoneIDDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	expectedALBZoneID, _ := staticdata.HostedZoneID(staticdata.ServiceALB, acctest.Region())
+	expectedNLBZoneID, _ := staticdata.HostedZoneID(staticdata.ServiceNLB, acctest.Region())
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
@@ -22,7 +25,7 @@ func TestAccELBV2HostedZoneIDDataSource_basic(t *testing.T) {
 			{
 				Config: testAccHostedZoneIDDataSourceConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("data.aws_lb_hosted_zone_id.main", "id", tfelbv2.HostedZoneIdPerRegionALBMap[acctest.Region()]),
+					resource.TestCheckResourceAttr("data.aws_lb_hosted_zone_id.main", "id", expectedALBZoneID),
 				),
 			},
 			{
@@ -34,7 +37,7 @@ func TestAccELBV2HostedZoneIDDataSource_basic(t *testing.T) {
 			{
 				Config: testAccHostedZoneIDDataSourceConfig_explicitNetwork,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("data.aws_lb_hosted_zone_id.network", "id", tfelbv2.HostedZoneIdPerRegionNLBMap[acctest.Region()]),
+					resource.TestCheckResourceAttr("data.aws_lb_hosted_zone_id.network", "id", expectedNLBZoneID),
 				),
 			},
 			{
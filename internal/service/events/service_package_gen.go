@@ -82,6 +82,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "events",
+				ResourcePrefix: "rule/",
+			},
 		},
 		{
 			Factory:  ResourceTarget,
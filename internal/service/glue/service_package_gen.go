@@ -105,6 +105,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "glue",
+				ResourcePrefix: "job/",
+			},
 		},
 		{
 			Factory:  ResourceMLTransform,
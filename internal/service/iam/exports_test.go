@@ -17,5 +17,11 @@ var (
 	FindAttachedUserPolicies            = findAttachedUserPolicies
 	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
 	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
+	FindGroupAttachedPolicies           = findGroupAttachedPolicies
+	FindGroupPolicyNames                = findGroupPolicyNames
 	FindPolicyByARN                     = findPolicyByARN
+	FindRoleAttachedPolicies            = findRoleAttachedPolicies
+	FindRolePolicyNames                 = findRolePolicyNames
+	FindUserAttachedPolicies            = findUserAttachedPolicies
+	FindUserPolicyNames                 = findUserPolicyNames
 )
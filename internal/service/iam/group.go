@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group")
+// @ARNImport(service="iam", resourcePrefix="group/", lastSegment=true)
 func ResourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
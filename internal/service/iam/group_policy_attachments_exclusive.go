@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
+)
+
+// @SDKResource("aws_iam_group_policy_attachments_exclusive", name="Group Policy Attachments Exclusive")
+func resourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validGroupPolicyGroup,
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	groupName := d.Get("group_name").(string)
+	policyARNs := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+
+	existing, err := findGroupAttachedPolicies(ctx, conn, groupName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group (%s) policy attachments: %s", groupName, err)
+	}
+
+	// Policies are attached by aws_iam_group_policy_attachment; only detach those that aren't listed.
+	if remove := tfslices.Filter(existing, func(v string) bool {
+		return !slices.Contains(policyARNs, v)
+	}); len(remove) > 0 {
+		if err := deleteGroupPolicyAttachments(ctx, conn, groupName, remove); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(groupName)
+	}
+
+	return append(diags, resourceGroupPolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group Policy Attachments Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := findGroupAttachedPolicies(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("group_name", d.Id())
+
+	return diags
+}
+
+func resourceGroupPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Policies are left attached; they are owned by their aws_iam_group_policy_attachment resources.
+	log.Printf("[DEBUG] Removing IAM Group Policy Attachments Exclusive (%s) from state", d.Id())
+
+	return nil // nosemgrep:ci.semgrep.pluginsdk.return-diags-not-nil
+}
+
+func findGroupAttachedPolicies(ctx context.Context, conn *iam.IAM, groupName string) ([]string, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	err := conn.ListAttachedGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttachedPolicies {
+			if v != nil {
+				output = append(output, aws.StringValue(v.PolicyArn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func deleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string, policyARNs []string) error {
+	var errs []error
+
+	for _, policyARN := range policyARNs {
+		input := &iam.DetachGroupPolicyInput{
+			PolicyArn: aws.String(policyARN),
+			GroupName: aws.String(groupName),
+		}
+
+		_, err := conn.DetachGroupPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("detaching IAM Policy (%s) from Group (%s): %w", policyARN, groupName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
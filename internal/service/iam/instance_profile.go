@@ -33,6 +33,7 @@ const (
 
 // @SDKResource("aws_iam_instance_profile", name="Instance Profile")
 // @Tags
+// @ARNImport(service="iam", resourcePrefix="instance-profile/", lastSegment=true)
 func ResourceInstanceProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceProfileCreate,
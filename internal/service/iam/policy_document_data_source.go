@@ -11,12 +11,18 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -39,6 +45,29 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minified_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minify": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_wildcards": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"merge_statements": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"override_policy_documents": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -59,6 +88,16 @@ func DataSourcePolicyDocument() *schema.Resource {
 					ValidateFunc: validation.StringIsJSON,
 				},
 			},
+			"split_json": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"split_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -106,6 +145,26 @@ func DataSourcePolicyDocument() *schema.Resource {
 					},
 				},
 			},
+			"validate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.PolicyTypeIdentityPolicy,
+							ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
+						},
+						"validate_policy_resource_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ValidatePolicyResourceType](),
+						},
+					},
+				},
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -268,12 +327,109 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
+	if v, ok := d.GetOk("validate"); ok && len(v.([]interface{})) > 0 {
+		tfMap, _ := v.([]interface{})[0].(map[string]interface{})
+		conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+		if diags = append(diags, validatePolicyDocument(ctx, conn, jsonString, tfMap)...); diags.HasError() {
+			return diags
+		}
+	}
+
 	d.Set("json", jsonString)
+
+	// minify and split_max_size operate on the compact encoding; json is always rendered verbatim
+	if v, ok := d.GetOk("minify"); ok && len(v.([]interface{})) > 0 {
+		mergeStatements := true
+		var actionWildcards []string
+
+		if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["action_wildcards"].(*schema.Set); ok && v.Len() > 0 {
+				actionWildcards = flex.ExpandStringValueSet(v)
+			}
+			if v, ok := tfMap["merge_statements"].(bool); ok {
+				mergeStatements = v
+			}
+		}
+
+		mergedDoc.Minify(actionWildcards, mergeStatements)
+
+		minifiedJSON, err := json.Marshal(mergedDoc)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting minified JSON: %s", err)
+		}
+
+		d.Set("minified_json", string(minifiedJSON))
+	}
+
+	if v, ok := d.GetOk("split_max_size"); ok {
+		docs, err := mergedDoc.Split(v.(int))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: splitting: %s", err)
+		}
+
+		var splitJSON []string
+		for _, doc := range docs {
+			b, err := json.Marshal(doc)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting split JSON: %s", err)
+			}
+
+			splitJSON = append(splitJSON, string(b))
+		}
+
+		d.Set("split_json", splitJSON)
+	}
+
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
 }
 
+// validatePolicyDocument validates a policy document with IAM Access Analyzer.
+// ERROR and SECURITY_WARNING findings are returned as errors, all other findings as warnings.
+func validatePolicyDocument(ctx context.Context, conn *accessanalyzer.Client, policy string, tfMap map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyType:     awstypes.PolicyTypeIdentityPolicy,
+	}
+
+	if v, ok := tfMap["policy_type"].(string); ok && v != "" {
+		input.PolicyType = awstypes.PolicyType(v)
+	}
+
+	if v, ok := tfMap["validate_policy_resource_type"].(string); ok && v != "" {
+		input.ValidatePolicyResourceType = awstypes.ValidatePolicyResourceType(v)
+	}
+
+	findings, err := tfaccessanalyzer.FindPolicyValidationFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating IAM Policy Document: %s", err)
+	}
+
+	for _, finding := range findings {
+		var locations []string
+		for _, v := range finding.Locations {
+			locations = append(locations, tfaccessanalyzer.PolicyValidationFindingPath(v))
+		}
+
+		format := "IAM Policy Document %s (%s) at [%s]: %s See %s"
+		args := []any{finding.FindingType, aws.StringValue(finding.IssueCode), strings.Join(locations, ", "), aws.StringValue(finding.FindingDetails), aws.StringValue(finding.LearnMoreLink)}
+
+		switch finding.FindingType {
+		case awstypes.ValidatePolicyFindingTypeError, awstypes.ValidatePolicyFindingTypeSecurityWarning:
+			diags = sdkdiag.AppendErrorf(diags, format, args...)
+		default:
+			diags = sdkdiag.AppendWarningf(diags, format, args...)
+		}
+	}
+
+	return diags
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
@@ -436,6 +436,76 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_validate(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_validate(`"arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/example"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "validate.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "validate.0.policy_type", "IDENTITY_POLICY"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validate(`"*"`),
+				ExpectError: regexache.MustCompile(`SECURITY_WARNING \(PASS_ROLE_WITH_STAR_IN_RESOURCE\)`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_minify(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject*"],"Resource":"arn:aws:s3:::bucket/*"},{"Sid":"List","Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::bucket"}]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_split(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_split,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.0", `{"Version":"2012-10-17","Statement":[{"Sid":"One","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::one/*"},{"Sid":"Two","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::two/*"}]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.1", `{"Version":"2012-10-17","Statement":[{"Sid":"Three","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::three/*"}]}`),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_splitTooSmall,
+				ExpectError: regexache.MustCompile(`exceeds the maximum policy size of 64`),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1614,3 +1684,79 @@ data "aws_iam_policy_document" "test" {
   override_policy_documents = ["{"]
 }
 `
+
+var testAccPolicyDocumentDataSourceConfig_minify = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject", "s3:GetObjectVersion"]
+    resources = ["arn:aws:s3:::bucket/*"]
+  }
+
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["arn:aws:s3:::bucket/*"]
+  }
+
+  statement {
+    sid       = "List"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::bucket"]
+  }
+
+  minify {
+    action_wildcards = ["s3:GetObject*"]
+  }
+}
+`
+
+var testAccPolicyDocumentDataSourceConfig_split = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "One"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::one/*"]
+  }
+
+  statement {
+    sid       = "Two"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::two/*"]
+  }
+
+  statement {
+    sid       = "Three"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::three/*"]
+  }
+
+  split_max_size = 220
+}
+`
+
+var testAccPolicyDocumentDataSourceConfig_splitTooSmall = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::one/*"]
+  }
+
+  split_max_size = 64
+}
+`
+
+func testAccPolicyDocumentDataSourceConfig_validate(resource string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["iam:PassRole"]
+    resources = [%[1]s]
+  }
+
+  validate {}
+}
+`, resource)
+}
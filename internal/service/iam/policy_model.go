@@ -6,8 +6,10 @@ package iam
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -298,3 +300,182 @@ func isValidPolicyAWSPrincipal(principal string) bool { // nosemgrep:ci.aws-in-f
 	}
 	return false
 }
+
+// Minify reduces the size of the policy document.
+// Actions matched by one of actionWildcards are replaced by that wildcard, and if mergeStatements is set,
+// statements without a Sid that differ only in their actions, or only in their resources, are combined.
+func (s *IAMPolicyDoc) Minify(actionWildcards []string, mergeStatements bool) {
+	for _, stmt := range s.Statements {
+		if len(actionWildcards) > 0 && stmt.Actions != nil {
+			stmt.Actions = policyCollapseActions(policyStringList(stmt.Actions), actionWildcards)
+		}
+
+		stmt.Actions = policyStringListValue(policyStringList(stmt.Actions))
+		stmt.NotActions = policyStringListValue(policyStringList(stmt.NotActions))
+		stmt.Resources = policyStringListValue(policyStringList(stmt.Resources))
+		stmt.NotResources = policyStringListValue(policyStringList(stmt.NotResources))
+	}
+
+	if !mergeStatements {
+		return
+	}
+
+	s.Statements = policyMergeStatements(s.Statements, func(stmt *IAMPolicyStatement) *interface{} { return &stmt.Actions })
+	s.Statements = policyMergeStatements(s.Statements, func(stmt *IAMPolicyStatement) *interface{} { return &stmt.Resources })
+}
+
+// Split splits the policy document into documents whose compact JSON encoding is at most maxSize characters.
+// Statements keep their order; each returned document carries the original Version and Id.
+func (s *IAMPolicyDoc) Split(maxSize int) ([]*IAMPolicyDoc, error) {
+	var docs []*IAMPolicyDoc
+	current := &IAMPolicyDoc{Version: s.Version, Id: s.Id}
+
+	for i, stmt := range s.Statements {
+		current.Statements = append(current.Statements, stmt)
+
+		size, err := current.size()
+		if err != nil {
+			return nil, err
+		}
+
+		if size <= maxSize {
+			continue
+		}
+
+		if len(current.Statements) > 1 {
+			current.Statements = current.Statements[:len(current.Statements)-1]
+			docs = append(docs, current)
+			current = &IAMPolicyDoc{Version: s.Version, Id: s.Id, Statements: []*IAMPolicyStatement{stmt}}
+
+			size, err = current.size()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if size > maxSize {
+			return nil, fmt.Errorf("statement %d is %d characters, which exceeds the maximum policy size of %d", i, size, maxSize)
+		}
+	}
+
+	if len(current.Statements) > 0 || len(docs) == 0 {
+		docs = append(docs, current)
+	}
+
+	return docs, nil
+}
+
+// size returns the length of the policy document's compact JSON encoding.
+func (s *IAMPolicyDoc) size() (int, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// policyMergeStatements combines statements without a Sid that are identical apart from the list returned by field.
+func policyMergeStatements(stmts []*IAMPolicyStatement, field func(*IAMPolicyStatement) *interface{}) []*IAMPolicyStatement {
+	var out []*IAMPolicyStatement
+	merged := make(map[string]*IAMPolicyStatement)
+
+	for _, stmt := range stmts {
+		v := field(stmt)
+		if stmt.Sid != "" || *v == nil {
+			out = append(out, stmt)
+			continue
+		}
+
+		values := *v
+		*v = nil
+		key, err := json.Marshal(stmt)
+		*v = values
+
+		if err != nil {
+			out = append(out, stmt)
+			continue
+		}
+
+		if existing, ok := merged[string(key)]; ok {
+			ev := field(existing)
+			*ev = policyStringListValue(append(policyStringList(*ev), policyStringList(values)...))
+			continue
+		}
+
+		merged[string(key)] = stmt
+		out = append(out, stmt)
+	}
+
+	return out
+}
+
+// policyCollapseActions replaces each action matched by one of wildcards with the first matching wildcard.
+func policyCollapseActions(actions, wildcards []string) []string {
+	out := make([]string, 0, len(actions))
+
+	for _, action := range actions {
+		for _, wildcard := range wildcards {
+			if policyActionMatchesWildcard(action, wildcard) {
+				action = wildcard
+				break
+			}
+		}
+
+		out = append(out, action)
+	}
+
+	return out
+}
+
+// policyActionMatchesWildcard reports whether action matches an IAM action wildcard ("*" and "?"), ignoring case.
+func policyActionMatchesWildcard(action, wildcard string) bool {
+	pattern := strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(wildcard))
+
+	return regexache.MustCompile(`(?i)^` + pattern + `$`).MatchString(action)
+}
+
+// policyStringList returns the values of a policy element that is a single string or a list of strings.
+func policyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				out = append(out, v)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// policyStringListValue returns the de-duplicated values in the form policyDecodeConfigStringList produces.
+func policyStringListValue(in []string) interface{} {
+	seen := make(map[string]struct{}, len(in))
+	out := make([]string, 0, len(in))
+
+	for _, v := range in {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+
+	switch len(out) {
+	case 0:
+		return nil
+	case 1:
+		return out[0]
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(out)))
+
+	return out
+}
@@ -5,10 +5,12 @@ package iam
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func TestPolicyHasValidAWSPrincipals(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -390,3 +392,198 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 		})
 	}
 }
+
+func TestIAMPolicyDoc_Minify(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy          string
+		actionWildcards []string
+		mergeStatements bool
+		want            string
+		equivalent      bool
+		equivalentTo    string
+	}{
+		"whitespace only": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::bucket/*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::bucket/*"
+    }
+  ]
+}`,
+			want:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			equivalent: true,
+		},
+		"duplicate actions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:GetObject"],
+      "Resource": "arn:aws:s3:::bucket/*"
+    }
+  ]
+}`,
+			want:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			equivalent: true,
+			// awspolicyequivalence compares lists by length, so duplicates are significant to it;
+			// compare against the input without the duplicate instead.
+			equivalentTo: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*"}]}`,
+		},
+		"merge actions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::bucket/*"},
+    {"Effect": "Deny", "Action": "s3:DeleteObject", "Resource": "arn:aws:s3:::bucket/*"}
+  ]
+}`,
+			mergeStatements: true,
+			want:            `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+		},
+		"merge resources": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::one/*"},
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::two/*"}
+  ]
+}`,
+			mergeStatements: true,
+			want:            `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::two/*","arn:aws:s3:::one/*"]}]}`,
+		},
+		"different conditions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": "true"}}},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}
+  ]
+}`,
+			mergeStatements: true,
+			want:            `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			equivalent:      true,
+		},
+		"sid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}
+  ]
+}`,
+			mergeStatements: true,
+			want:            `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			equivalent:      true,
+		},
+		"action wildcards": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": ["s3:GetObject", "s3:getobjectversion", "s3:PutObject"], "Resource": "*"}
+  ]
+}`,
+			actionWildcards: []string{"s3:GetObject*"},
+			want:            `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject*"],"Resource":"*"}]}`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(testcase.policy), doc); err != nil {
+				t.Fatalf("unmarshaling policy: %s", err)
+			}
+
+			doc.Minify(testcase.actionWildcards, testcase.mergeStatements)
+
+			b, err := json.Marshal(doc)
+			if err != nil {
+				t.Fatalf("marshaling policy: %s", err)
+			}
+
+			if got := string(b); got != testcase.want {
+				t.Errorf("got %s, want %s", got, testcase.want)
+			}
+
+			equivalentTo := testcase.policy
+			if testcase.equivalentTo != "" {
+				equivalentTo = testcase.equivalentTo
+			}
+
+			if got := verify.PolicyStringsEquivalent(string(b), equivalentTo); got != testcase.equivalent {
+				t.Errorf("equivalent: got %t, want %t", got, testcase.equivalent)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDoc_Split(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	doc := &IAMPolicyDoc{Version: "2012-10-17"}
+	for i := 0; i < 10; i++ {
+		doc.Statements = append(doc.Statements, &IAMPolicyStatement{
+			Sid:       fmt.Sprintf("Statement%d", i),
+			Effect:    "Allow",
+			Actions:   "s3:GetObject",
+			Resources: fmt.Sprintf("arn:aws:s3:::bucket-%d/*", i),
+		})
+	}
+
+	want, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("marshaling policy: %s", err)
+	}
+
+	docs, err := doc.Split(300)
+	if err != nil {
+		t.Fatalf("splitting policy: %s", err)
+	}
+
+	if len(docs) < 2 {
+		t.Fatalf("got %d documents, want at least 2", len(docs))
+	}
+
+	joined := &IAMPolicyDoc{Version: doc.Version}
+	for i, v := range docs {
+		size, err := v.size()
+		if err != nil {
+			t.Fatalf("marshaling document %d: %s", i, err)
+		}
+
+		if size > 300 {
+			t.Errorf("document %d is %d characters, want at most 300", i, size)
+		}
+
+		joined.Statements = append(joined.Statements, v.Statements...)
+	}
+
+	got, err := json.Marshal(joined)
+	if err != nil {
+		t.Fatalf("marshaling policy: %s", err)
+	}
+
+	if !verify.PolicyStringsEquivalent(string(got), string(want)) {
+		t.Errorf("split documents are not equivalent to the original: got %s, want %s", got, want)
+	}
+
+	if _, err := doc.Split(50); err == nil {
+		t.Error("expected error splitting into documents smaller than a statement")
+	}
+}
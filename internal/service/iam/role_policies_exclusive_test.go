@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMRolePoliciesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies_exclusive.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesExclusiveConfig_basic(rName, "role"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, roleResourceName, &role),
+					testAccCheckPoliciesExclusiveCount(ctx, resourceName, "role", 1),
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", roleResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_names.*", "aws_iam_role_policy.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePoliciesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies_exclusive.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesExclusiveConfig_basic(rName, "role"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, roleResourceName, &role),
+					testAccCheckPoliciesExclusiveCount(ctx, resourceName, "role", 1),
+					testAccCheckPolicyAddOutOfBand(ctx, "role", rName, rName+"-oob"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPoliciesExclusiveConfig_basic(rName, "role"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExclusiveCount(ctx, resourceName, "role", 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPoliciesExclusiveCount(ctx context.Context, n, principalType string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+		name := rs.Primary.Attributes[principalType+"_name"]
+
+		var output []string
+		var err error
+		switch principalType {
+		case "group":
+			output, err = tfiam.FindGroupPolicyNames(ctx, conn, name)
+		case "role":
+			output, err = tfiam.FindRolePolicyNames(ctx, conn, name)
+		case "user":
+			output, err = tfiam.FindUserPolicyNames(ctx, conn, name)
+		default:
+			return fmt.Errorf("unsupported IAM principal type: %s", principalType)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("IAM %s (%s) has %d inline policies, want %d", principalType, name, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyAddOutOfBand(ctx context.Context, principalType, principalName, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+		policyDocument := aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:Describe*","Resource":"*"}]}`)
+
+		var err error
+		switch principalType {
+		case "group":
+			_, err = conn.PutGroupPolicyWithContext(ctx, &iam.PutGroupPolicyInput{
+				GroupName:      aws.String(principalName),
+				PolicyDocument: policyDocument,
+				PolicyName:     aws.String(policyName),
+			})
+		case "role":
+			_, err = conn.PutRolePolicyWithContext(ctx, &iam.PutRolePolicyInput{
+				PolicyDocument: policyDocument,
+				PolicyName:     aws.String(policyName),
+				RoleName:       aws.String(principalName),
+			})
+		case "user":
+			_, err = conn.PutUserPolicyWithContext(ctx, &iam.PutUserPolicyInput{
+				PolicyDocument: policyDocument,
+				PolicyName:     aws.String(policyName),
+				UserName:       aws.String(principalName),
+			})
+		default:
+			err = fmt.Errorf("unsupported IAM principal type: %s", principalType)
+		}
+
+		return err
+	}
+}
+
+// testAccExclusivePrincipalConfig_base creates an IAM group, role or user named rName.
+func testAccExclusivePrincipalConfig_base(rName, principalType string) string {
+	if principalType != "role" {
+		return fmt.Sprintf(`
+resource "aws_iam_%[2]s" "test" {
+  name = %[1]q
+}
+`, rName, principalType)
+	}
+
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPoliciesExclusiveConfig_basic(rName, principalType string) string {
+	return acctest.ConfigCompose(testAccExclusivePrincipalConfig_base(rName, principalType), fmt.Sprintf(`
+resource "aws_iam_%[2]s_policy" "test" {
+  %[2]s = aws_iam_%[2]s.test.name
+
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:ListAllMyBuckets"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_%[2]s_policies_exclusive" "test" {
+  %[2]s_name = aws_iam_%[2]s.test.name
+
+  policy_names = [aws_iam_%[2]s_policy.test.name]
+}
+`, rName, principalType))
+}
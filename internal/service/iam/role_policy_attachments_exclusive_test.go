@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMRolePolicyAttachmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentsExclusiveConfig_basic(rName, "role"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, roleResourceName, &role),
+					testAccCheckPolicyAttachmentsExclusiveCount(ctx, resourceName, "role", 1),
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", roleResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentsExclusiveConfig_basic(rName, "role"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, roleResourceName, &role),
+					testAccCheckPolicyAttachmentsExclusiveCount(ctx, resourceName, "role", 1),
+					testAccCheckPolicyAttachOutOfBand(ctx, "role", rName, "ReadOnlyAccess"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPolicyAttachmentsExclusiveConfig_basic(rName, "role"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusiveCount(ctx, resourceName, "role", 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyAttachmentsExclusiveCount(ctx context.Context, n, principalType string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+		name := rs.Primary.Attributes[principalType+"_name"]
+
+		var output []string
+		var err error
+		switch principalType {
+		case "group":
+			output, err = tfiam.FindGroupAttachedPolicies(ctx, conn, name)
+		case "role":
+			output, err = tfiam.FindRoleAttachedPolicies(ctx, conn, name)
+		case "user":
+			output, err = tfiam.FindUserAttachedPolicies(ctx, conn, name)
+		default:
+			return fmt.Errorf("unsupported IAM principal type: %s", principalType)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("IAM %s (%s) has %d attached policies, want %d", principalType, name, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyAttachOutOfBand(ctx context.Context, principalType, principalName, awsManagedPolicyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn(ctx)
+		policyARN := aws.String("arn:" + acctest.Partition() + ":iam::aws:policy/" + awsManagedPolicyName)
+
+		var err error
+		switch principalType {
+		case "group":
+			_, err = conn.AttachGroupPolicyWithContext(ctx, &iam.AttachGroupPolicyInput{
+				GroupName: aws.String(principalName),
+				PolicyArn: policyARN,
+			})
+		case "role":
+			_, err = conn.AttachRolePolicyWithContext(ctx, &iam.AttachRolePolicyInput{
+				PolicyArn: policyARN,
+				RoleName:  aws.String(principalName),
+			})
+		case "user":
+			_, err = conn.AttachUserPolicyWithContext(ctx, &iam.AttachUserPolicyInput{
+				PolicyArn: policyARN,
+				UserName:  aws.String(principalName),
+			})
+		default:
+			err = fmt.Errorf("unsupported IAM principal type: %s", principalType)
+		}
+
+		return err
+	}
+}
+
+func testAccPolicyAttachmentsExclusiveConfig_basic(rName, principalType string) string {
+	return acctest.ConfigCompose(testAccExclusivePrincipalConfig_base(rName, principalType), fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:ListAllMyBuckets"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_%[2]s_policy_attachment" "test" {
+  %[2]s = aws_iam_%[2]s.test.name
+
+  policy_arn = aws_iam_policy.test.arn
+}
+
+resource "aws_iam_%[2]s_policy_attachments_exclusive" "test" {
+  %[2]s_name = aws_iam_%[2]s.test.name
+
+  policy_arns = [aws_iam_%[2]s_policy_attachment.test.policy_arn]
+}
+`, rName, principalType))
+}
@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_iam_server_certificate", name="Server Certificate")
 // @Tags
+// @ARNImport(service="iam", resourcePrefix="server-certificate/", lastSegment=true)
 func ResourceServerCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServerCertificateCreate,
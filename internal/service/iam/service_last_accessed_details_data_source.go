@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_iam_service_last_accessed_details", name="Service Last Accessed Details")
+func dataSourceServiceLastAccessedDetails() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceLastAccessedDetailsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"granularity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.AccessAdvisorUsageGranularityTypeServiceLevel,
+				ValidateFunc: validation.StringInSlice(iam.AccessAdvisorUsageGranularityType_Values(), false),
+			},
+			"job_completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"services_last_accessed": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_authenticated_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tracked_actions_last_accessed": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_entity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceLastAccessedDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	arn := d.Get("arn").(string)
+	input := &iam.GenerateServiceLastAccessedDetailsInput{
+		Arn:         aws.String(arn),
+		Granularity: aws.String(d.Get("granularity").(string)),
+	}
+
+	output, err := conn.GenerateServiceLastAccessedDetailsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating IAM Service Last Accessed Details (%s): %s", arn, err)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+
+	details, err := waitServiceLastAccessedDetailsJobCompleted(ctx, conn, jobID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Service Last Accessed Details (%s) job (%s) complete: %s", arn, jobID, err)
+	}
+
+	d.SetId(arn)
+	d.Set("job_completion_date", aws.TimeValue(details.JobCompletionDate).Format(time.RFC3339))
+	d.Set("job_creation_date", aws.TimeValue(details.JobCreationDate).Format(time.RFC3339))
+	d.Set("job_id", jobID)
+	if err := d.Set("services_last_accessed", flattenServicesLastAccessed(details.ServicesLastAccessed)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting services_last_accessed: %s", err)
+	}
+
+	return diags
+}
+
+func findServiceLastAccessedDetailsByJobID(ctx context.Context, conn *iam.IAM, jobID string) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(jobID),
+	}
+	var output *iam.GetServiceLastAccessedDetailsOutput
+
+	for {
+		page, err := conn.GetServiceLastAccessedDetailsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.ServicesLastAccessed = append(output.ServicesLastAccessed, page.ServicesLastAccessed...)
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+
+		input.Marker = page.Marker
+	}
+
+	return output, nil
+}
+
+func flattenServicesLastAccessed(apiObjects []*iam.ServiceLastAccessed) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"last_authenticated_entity":     aws.StringValue(apiObject.LastAuthenticatedEntity),
+			"last_authenticated_region":     aws.StringValue(apiObject.LastAuthenticatedRegion),
+			"service_name":                  aws.StringValue(apiObject.ServiceName),
+			"service_namespace":             aws.StringValue(apiObject.ServiceNamespace),
+			"total_authenticated_entities":  aws.Int64Value(apiObject.TotalAuthenticatedEntities),
+			"tracked_actions_last_accessed": flattenTrackedActionsLastAccessed(apiObject.TrackedActionsLastAccessed),
+		}
+
+		if v := apiObject.LastAuthenticated; v != nil {
+			tfMap["last_authenticated"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTrackedActionsLastAccessed(apiObjects []*iam.TrackedActionLastAccessed) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action_name":          aws.StringValue(apiObject.ActionName),
+			"last_accessed_entity": aws.StringValue(apiObject.LastAccessedEntity),
+			"last_accessed_region": aws.StringValue(apiObject.LastAccessedRegion),
+		}
+
+		if v := apiObject.LastAccessedTime; v != nil {
+			tfMap["last_accessed_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
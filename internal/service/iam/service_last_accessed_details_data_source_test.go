@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMServiceLastAccessedDetailsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_service_last_accessed_details.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLastAccessedDetailsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "SERVICE_LEVEL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_completion_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "services_last_accessed.*", map[string]string{
+						"service_namespace":               "s3",
+						"tracked_actions_last_accessed.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMServiceLastAccessedDetailsDataSource_actionLevel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_service_last_accessed_details.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLastAccessedDetailsDataSourceConfig_actionLevel(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "ACTION_LEVEL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "services_last_accessed.*", map[string]string{
+						"service_namespace": "s3",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceLastAccessedDetailsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:ListAllMyBuckets"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccServiceLastAccessedDetailsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceLastAccessedDetailsDataSourceConfig_base(rName), `
+data "aws_iam_service_last_accessed_details" "test" {
+  arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccServiceLastAccessedDetailsDataSourceConfig_actionLevel(rName string) string {
+	return acctest.ConfigCompose(testAccServiceLastAccessedDetailsDataSourceConfig_base(rName), `
+data "aws_iam_service_last_accessed_details" "test" {
+  arn         = aws_iam_role.test.arn
+  granularity = "ACTION_LEVEL"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
@@ -77,6 +77,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceServerCertificate,
 			TypeName: "aws_iam_server_certificate",
 		},
+		{
+			Factory:  dataSourceServiceLastAccessedDetails,
+			TypeName: "aws_iam_service_last_accessed_details",
+			Name:     "Service Last Accessed Details",
+		},
 		{
 			Factory:  DataSourceSessionContext,
 			TypeName: "aws_iam_session_context",
@@ -113,11 +118,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceGroup,
 			TypeName: "aws_iam_group",
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "iam",
+				ResourcePrefix: "group/",
+				LastSegment:    true,
+			},
 		},
 		{
 			Factory:  ResourceGroupMembership,
 			TypeName: "aws_iam_group_membership",
 		},
+		{
+			Factory:  resourceGroupPoliciesExclusive,
+			TypeName: "aws_iam_group_policies_exclusive",
+			Name:     "Group Policies Exclusive",
+		},
 		{
 			Factory:  ResourceGroupPolicy,
 			TypeName: "aws_iam_group_policy",
@@ -127,11 +142,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_group_policy_attachment",
 			Name:     "Group Policy Attachment",
 		},
+		{
+			Factory:  resourceGroupPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_group_policy_attachments_exclusive",
+			Name:     "Group Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
 			Name:     "Instance Profile",
 			Tags:     &types.ServicePackageResourceTags{},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "iam",
+				ResourcePrefix: "instance-profile/",
+				LastSegment:    true,
+			},
 		},
 		{
 			Factory:  ResourceOpenIDConnectProvider,
@@ -155,6 +180,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_role",
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "iam",
+				ResourcePrefix: "role/",
+				LastSegment:    true,
+			},
+		},
+		{
+			Factory:  resourceRolePoliciesExclusive,
+			TypeName: "aws_iam_role_policies_exclusive",
+			Name:     "Role Policies Exclusive",
 		},
 		{
 			Factory:  ResourceRolePolicy,
@@ -165,6 +200,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
 		},
+		{
+			Factory:  resourceRolePolicyAttachmentsExclusive,
+			TypeName: "aws_iam_role_policy_attachments_exclusive",
+			Name:     "Role Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
@@ -181,6 +221,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_server_certificate",
 			Name:     "Server Certificate",
 			Tags:     &types.ServicePackageResourceTags{},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "iam",
+				ResourcePrefix: "server-certificate/",
+				LastSegment:    true,
+			},
 		},
 		{
 			Factory:  ResourceServiceLinkedRole,
@@ -201,6 +246,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_user",
 			Name:     "User",
 			Tags:     &types.ServicePackageResourceTags{},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "iam",
+				ResourcePrefix: "user/",
+				LastSegment:    true,
+			},
 		},
 		{
 			Factory:  ResourceUserGroupMembership,
@@ -210,6 +260,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserLoginProfile,
 			TypeName: "aws_iam_user_login_profile",
 		},
+		{
+			Factory:  resourceUserPoliciesExclusive,
+			TypeName: "aws_iam_user_policies_exclusive",
+			Name:     "User Policies Exclusive",
+		},
 		{
 			Factory:  ResourceUserPolicy,
 			TypeName: "aws_iam_user_policy",
@@ -219,6 +274,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_user_policy_attachment",
 			Name:     "User Policy Attachment",
 		},
+		{
+			Factory:  resourceUserPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_user_policy_attachments_exclusive",
+			Name:     "User Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
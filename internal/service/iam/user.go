@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_iam_user", name="User")
 // @Tags
+// @ARNImport(service="iam", resourcePrefix="user/", lastSegment=true)
 func ResourceUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
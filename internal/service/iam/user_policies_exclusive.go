@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
+)
+
+// @SDKResource("aws_iam_user_policies_exclusive", name="User Policies Exclusive")
+func resourceUserPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceUserPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceUserPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validUserPolicyUser,
+			},
+		},
+	}
+}
+
+func resourceUserPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	userName := d.Get("user_name").(string)
+	policyNames := flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set))
+
+	existing, err := findUserPolicyNames(ctx, conn, userName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User (%s) inline policies: %s", userName, err)
+	}
+
+	// Inline policies are created by aws_iam_user_policy; only remove those that aren't listed.
+	if remove := tfslices.Filter(existing, func(v string) bool {
+		return !slices.Contains(policyNames, v)
+	}); len(remove) > 0 {
+		if err := deleteUserInlinePolicies(ctx, conn, userName, remove); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(userName)
+	}
+
+	return append(diags, resourceUserPoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceUserPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn(ctx)
+
+	_, err := FindUserByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyNames, err := findUserPolicyNames(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM User Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("user_name", d.Id())
+
+	return diags
+}
+
+func resourceUserPoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Inline policies are left in place; they are owned by their aws_iam_user_policy resources.
+	log.Printf("[DEBUG] Removing IAM User Policies Exclusive (%s) from state", d.Id())
+
+	return nil // nosemgrep:ci.semgrep.pluginsdk.return-diags-not-nil
+}
+
+func findUserPolicyNames(ctx context.Context, conn *iam.IAM, userName string) ([]string, error) {
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+	var output []string
+
+	err := conn.ListUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PolicyNames {
+			if v != nil {
+				output = append(output, aws.StringValue(v))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func deleteUserInlinePolicies(ctx context.Context, conn *iam.IAM, userName string, policyNames []string) error {
+	var errs []error
+
+	for _, policyName := range policyNames {
+		if len(policyName) == 0 {
+			continue
+		}
+
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(policyName),
+			UserName:   aws.String(userName),
+		}
+
+		_, err := conn.DeleteUserPolicyWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting IAM User (%s) policy (%s): %w", userName, policyName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
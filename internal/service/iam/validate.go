@@ -64,3 +64,25 @@ var validRolePolicyRole = validation.All(
 		return
 	},
 )
+
+var validGroupPolicyGroup = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexache.MustCompile(`[\w+=,.@-]+`), ""),
+	func(v interface{}, k string) (ws []string, es []error) {
+		if _, errs := verify.ValidARN(v, k); len(errs) == 0 {
+			es = append(es, fmt.Errorf("%q must be the group's name not its ARN", k))
+		}
+		return
+	},
+)
+
+var validUserPolicyUser = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexache.MustCompile(`[\w+=,.@-]+`), ""),
+	func(v interface{}, k string) (ws []string, es []error) {
+		if _, errs := verify.ValidARN(v, k); len(errs) == 0 {
+			es = append(es, fmt.Errorf("%q must be the user's name not its ARN", k))
+		}
+		return
+	},
+)
@@ -129,3 +129,59 @@ func TestValidRolePolicyRoleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidGroupPolicyGroupName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value: "Developers",
+		},
+		{
+			Value: "path/Developers",
+		},
+		{
+			Value:    "arn:aws:iam::123456789012:group/Developers", // lintignore:AWSAT005
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validGroupPolicyGroup(tc.Value, "group")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d Group Policy group name validation errors, got %d", tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestValidUserPolicyUserName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value: "developer",
+		},
+		{
+			Value: "path/developer",
+		},
+		{
+			Value:    "arn:aws:iam::123456789012:user/developer", // lintignore:AWSAT005
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validUserPolicyUser(tc.Value, "user")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d User Policy user name validation errors, got %d", tc.ErrCount, len(errors))
+		}
+	}
+}
@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -69,3 +70,41 @@ func statusRoleCreate(ctx context.Context, conn *iam.IAM, id string) retry.State
 		return role, RoleStatusARNIsUniqueID, nil
 	}
 }
+
+func waitServiceLastAccessedDetailsJobCompleted(ctx context.Context, conn *iam.IAM, jobID string, timeout time.Duration) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iam.JobStatusTypeInProgress},
+		Target:  []string{iam.JobStatusTypeCompleted},
+		Refresh: statusServiceLastAccessedDetailsJob(ctx, conn, jobID),
+		Timeout: timeout,
+		Delay:   2 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iam.GetServiceLastAccessedDetailsOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusServiceLastAccessedDetailsJob(ctx context.Context, conn *iam.IAM, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findServiceLastAccessedDetailsByJobID(ctx, conn, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobStatus), nil
+	}
+}
@@ -89,6 +89,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "kms",
+				ResourcePrefix: "key/",
+			},
 		},
 		{
 			Factory:  ResourceKeyPolicy,
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// archiveModifiedTime is the modification time recorded for every file in a source directory archive.
+// Using a fixed time, rather than each file's own, keeps the archive (and so its hash) stable across checkouts.
+var archiveModifiedTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// archiveSourceDir returns a ZIP archive of the contents of the specified directory.
+// The archive is reproducible: files are added in lexical order with fixed modification times and normalized permissions.
+// Files and directories whose relative path, or whose name if the pattern contains no "/", matches any of the exclude patterns are skipped.
+func archiveSourceDir(dir string, excludes []string) ([]byte, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		excluded, err := sourceDirExcluded(rel, excludes)
+		if err != nil {
+			return err
+		}
+
+		if excluded {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		info, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return fmt.Errorf("%s: symbolic links to directories are not supported", rel)
+		}
+
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%s: not a regular file", rel)
+		}
+
+		return addFileToArchive(w, p, rel, info.Mode())
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func addFileToArchive(w *zip.Writer, p, name string, mode fs.FileMode) error {
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: archiveModifiedTime,
+	}
+	// Only the executable bit is significant to Lambda.
+	if mode&0o111 != 0 {
+		header.SetMode(0o755)
+	} else {
+		header.SetMode(0o644)
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fw, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, f)
+
+	return err
+}
+
+func sourceDirExcluded(rel string, excludes []string) (bool, error) {
+	for _, pattern := range excludes {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("exclude pattern (%s): %w", pattern, err)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// sourceCodeHash returns the base64-encoded SHA-256 hash of the specified deployment package, as reported by Lambda's CodeSha256.
+func sourceCodeHash(zipFile []byte) string {
+	hash := sha256.Sum256(zipFile)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
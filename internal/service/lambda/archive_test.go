@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestArchiveSourceDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"index.js":            "exports.handler = async () => {};",
+		"lib/util.js":         "module.exports = {};",
+		"README.md":           "# Example",
+		"docs/guide.txt":      "Guide",
+		"node_modules/.cache": "cache",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chmod(filepath.Join(dir, "index.js"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	excludes := []string{"*.md", "docs", "node_modules/*"}
+
+	got1, err := archiveSourceDir(dir, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Modification times must not affect the archive.
+	later := time.Now().Add(time.Hour)
+	for name := range files {
+		if err := os.Chtimes(filepath.Join(dir, filepath.FromSlash(name)), later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got2, err := archiveSourceDir(dir, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got1, got2) {
+		t.Error("archives differ")
+	}
+
+	if got, want := sourceCodeHash(got1), sourceCodeHash(got2); got != want {
+		t.Errorf("sourceCodeHash = %s, want %s", got, want)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(got1), int64(len(got1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	modes := map[string]os.FileMode{}
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode()
+	}
+
+	if diff := cmp.Diff(names, []string{"index.js", "lib/util.js"}); diff != "" {
+		t.Errorf("unexpected names diff (+want, -got): %s", diff)
+	}
+
+	if got, want := modes["index.js"], os.FileMode(0o755); got != want {
+		t.Errorf("index.js mode = %s, want %s", got, want)
+	}
+
+	if got, want := modes["lib/util.js"], os.FileMode(0o644); got != want {
+		t.Errorf("lib/util.js mode = %s, want %s", got, want)
+	}
+}
+
+func TestSourceDirExcluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rel      string
+		excludes []string
+		want     bool
+	}{
+		"no patterns": {
+			rel:  "index.js",
+			want: false,
+		},
+		"name pattern": {
+			rel:      "lib/test.pyc",
+			excludes: []string{"*.pyc"},
+			want:     true,
+		},
+		"name pattern no match": {
+			rel:      "lib/test.py",
+			excludes: []string{"*.pyc"},
+			want:     false,
+		},
+		"path pattern": {
+			rel:      "tests/unit",
+			excludes: []string{"tests/*"},
+			want:     true,
+		},
+		"path pattern not in subdirectory": {
+			rel:      "src/tests/unit",
+			excludes: []string{"tests/*"},
+			want:     false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sourceDirExcluded(testCase.rel, testCase.excludes)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("sourceDirExcluded(%q, %q) = %t, want %t", testCase.rel, testCase.excludes, got, testCase.want)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -43,6 +44,7 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="lambda", resourcePrefix="function:")
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionCreate,
@@ -169,7 +171,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +210,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -288,10 +290,29 @@ func ResourceFunction() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[types.Runtime](),
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"update_runtime_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(types.UpdateRuntimeOnFunctionUpdate, types.UpdateRuntimeOnManual), false),
+						},
+					},
+				},
+			},
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -302,7 +323,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -344,6 +365,18 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validSourceDirExclude()},
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -422,6 +455,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashFromSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -462,6 +496,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 		}
 
+		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		zipFile, err := archiveSourceDir(v.(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "archiving source directory (%s): %s", v, err)
+		}
+
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
@@ -567,6 +612,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for completion: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandRuntimeManagementConfig(v.([]interface{}))
+		input.FunctionName = aws.String(d.Id())
+
+		_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 		_, err := conn.PutFunctionConcurrency(ctx, &lambda.PutFunctionConcurrencyInput{
 			FunctionName:                 aws.String(d.Id()),
@@ -654,6 +710,29 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
+	// Runtime management is only supported on zip packaged lambda functions.
+	if function.PackageType == types.PackageTypeZip {
+		output, err := conn.GetRuntimeManagementConfig(ctx, &lambda.GetRuntimeManagementConfigInput{
+			FunctionName: aws.String(d.Id()),
+			Qualifier:    input.Qualifier,
+		})
+
+		switch {
+		// Callers without lambda:GetRuntimeManagementConfig permission can still manage functions
+		// that don't configure runtime management.
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException):
+			log.Printf("[WARN] reading Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+			d.Set("runtime_management_config", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		default:
+			if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(output)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting runtime_management_config: %s", err)
+			}
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
@@ -914,6 +993,21 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 			}
 
+			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			zipFile, err := archiveSourceDir(v.(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+			if err != nil {
+				// As source_dir isn't set in resourceFunctionRead(), don't ovewrite the last known good value.
+				old, _ := d.GetChange("source_dir")
+				d.Set("source_dir", old)
+
+				return sdkdiag.AppendErrorf(diags, "archiving source directory (%s): %s", v, err)
+			}
+
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
@@ -966,6 +1060,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("runtime_management_config") {
+		input := expandRuntimeManagementConfig(d.Get("runtime_management_config").([]interface{}))
+		input.FunctionName = aws.String(d.Id())
+
+		_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1236,6 +1341,31 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateSourceCodeHashFromSourceDir sets source_code_hash to the hash of the archive built from source_dir,
+// so that any change to the directory's contents results in a code update.
+func updateSourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	v, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil
+	}
+
+	zipFile, err := archiveSourceDir(v.(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return fmt.Errorf("archiving source directory (%s): %w", v, err)
+	}
+
+	if hash := sourceCodeHash(zipFile); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
@@ -1243,6 +1373,8 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("source_dir") ||
+		d.HasChange("source_dir_excludes") ||
 		d.HasChange("architectures")
 }
 
@@ -1423,6 +1555,33 @@ func flattenSnapStart(apiObject *types.SnapStartResponse) []interface{} {
 	return []interface{}{m}
 }
 
+func expandRuntimeManagementConfig(tfList []interface{}) *lambda.PutRuntimeManagementConfigInput {
+	input := &lambda.PutRuntimeManagementConfigInput{UpdateRuntimeOn: types.UpdateRuntimeOnAuto}
+	if len(tfList) == 1 && tfList[0] != nil {
+		item := tfList[0].(map[string]interface{})
+		input.UpdateRuntimeOn = types.UpdateRuntimeOn(item["update_runtime_on"].(string))
+		if v, ok := item["runtime_version_arn"].(string); ok && v != "" {
+			input.RuntimeVersionArn = aws.String(v)
+		}
+	}
+	return input
+}
+
+func flattenRuntimeManagementConfig(apiObject *lambda.GetRuntimeManagementConfigOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+	if apiObject.UpdateRuntimeOn == types.UpdateRuntimeOnAuto {
+		return nil
+	}
+	m := map[string]interface{}{
+		"runtime_version_arn": aws.ToString(apiObject.RuntimeVersionArn),
+		"update_runtime_on":   string(apiObject.UpdateRuntimeOn),
+	}
+
+	return []interface{}{m}
+}
+
 func expandArchitectures(tfList []interface{}) []types.Architecture {
 	vs := make([]types.Architecture, 0, len(tfList))
 	for _, v := range tfList {
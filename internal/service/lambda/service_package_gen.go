@@ -79,6 +79,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "lambda",
+				ResourcePrefix: "function:",
+			},
 		},
 		{
 			Factory:  ResourceFunctionEventInvokeConfig,
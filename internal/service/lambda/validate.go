@@ -4,6 +4,9 @@
 package lambda
 
 import (
+	"fmt"
+	"path"
+
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -47,3 +50,19 @@ func validPolicyStatementID() schema.SchemaValidateFunc {
 		validation.StringLenBetween(1, 100),
 	)
 }
+
+func validSourceDirExclude() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if _, err := path.Match(value, ""); err != nil {
+			errors = append(errors, fmt.Errorf("%q must be a valid glob pattern: %w", k, err))
+		}
+
+		return
+	}
+}
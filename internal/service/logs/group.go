@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
 // @Tags
+// @ARNImport(service="logs", resourcePrefix="log-group:", resourceSuffix=":*")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -62,6 +62,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cloudwatch_log_group",
 			Name:     "Log Group",
 			Tags:     &types.ServicePackageResourceTags{},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "logs",
+				ResourcePrefix: "log-group:",
+				ResourceSuffix: ":*",
+			},
 		},
 		{
 			Factory:  resourceMetricFilter,
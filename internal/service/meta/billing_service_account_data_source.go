@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
 )
 
 // @FrameworkDataSource
@@ -57,11 +58,17 @@ func (d *dataSourceBillingServiceAccount) Read(ctx context.Context, request data
 		return
 	}
 
-	// See http://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/billing-getting-started.html#step-2
-	const billingAccountID = "386209384616"
+	partition := d.Meta().Partition
+	billingAccountID, err := staticdata.LookupForPartition(staticdata.KindAccountID, staticdata.ServiceBilling, partition)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Billing Service Account", err.Error())
+
+		return
+	}
 
 	arn := arn.ARN{
-		Partition: d.Meta().Partition,
+		Partition: partition,
 		Service:   "iam",
 		AccountID: billingAccountID,
 		Resource:  "root",
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
+)
+
+// @FrameworkDataSource
+func newDataSourceStaticData(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceStaticData{}, nil
+}
+
+type dataSourceStaticData struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceStaticData) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_static_data"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceStaticData) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"kind": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[staticdata.Kind](),
+				},
+			},
+			"partition": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"service": schema.StringAttribute{
+				Required: true,
+			},
+			"value": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceStaticData) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceStaticDataData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := d.Meta().Region
+	if !data.Region.IsNull() {
+		region = data.Region.ValueString()
+	}
+
+	kind, service := staticdata.Kind(data.Kind.ValueString()), data.Service.ValueString()
+	partition := staticdata.PartitionForRegion(region)
+
+	var value string
+
+	switch kind {
+	case staticdata.KindServicePrincipal:
+		value = staticdata.ServicePrincipal(service, partition)
+	default:
+		v, err := staticdata.Lookup(kind, service, region)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading static data", err.Error())
+
+			return
+		}
+
+		value = v
+	}
+
+	data.ID = types.StringValue(value)
+	data.Partition = types.StringValue(partition)
+	data.Region = types.StringValue(region)
+	data.Value = types.StringValue(value)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceStaticDataData struct {
+	ID        types.String `tfsdk:"id"`
+	Kind      types.String `tfsdk:"kind"`
+	Partition types.String `tfsdk:"partition"`
+	Region    types.String `tfsdk:"region"`
+	Service   types.String `tfsdk:"service"`
+	Value     types.String `tfsdk:"value"`
+}
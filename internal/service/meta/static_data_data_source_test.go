@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/internal/staticdata"
+)
+
+func TestAccMetaStaticDataDataSource_hostedZoneID(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_static_data.test"
+	expected, _ := staticdata.HostedZoneID(staticdata.ServiceS3Website, acctest.Region())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticDataDataSourceConfig_basic("hosted_zone_id", staticdata.ServiceS3Website),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", expected),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, "region", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaStaticDataDataSource_servicePrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_static_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticDataDataSourceConfig_basic("service_principal", "logs"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", staticdata.ServicePrincipal("logs", acctest.Partition())),
+				),
+			},
+		},
+	})
+}
+
+func testAccStaticDataDataSourceConfig_basic(kind, service string) string {
+	return fmt.Sprintf(`
+data "aws_static_data" "test" {
+  kind    = %[1]q
+  service = %[2]q
+}
+`, kind, service)
+}
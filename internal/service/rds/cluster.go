@@ -5,12 +5,14 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -29,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -39,6 +42,7 @@ const (
 
 // @SDKResource("aws_rds_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(service="rds", resourcePrefix="cluster:")
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -104,6 +108,19 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -554,6 +571,20 @@ func ResourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				if engine := d.Get("engine").(string); !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -1184,6 +1215,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
 	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
 	d.Set("reader_endpoint", dbc.ReaderEndpoint)
+	o := d.Get("replication_source_identifier").(string)
 	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
 	if dbc.ScalingConfigurationInfo != nil {
 		if err := d.Set("scaling_configuration", []interface{}{flattenScalingConfigurationInfo(dbc.ScalingConfigurationInfo)}); err != nil {
@@ -1215,6 +1247,12 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 		if err == nil {
 			d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+
+			// Secondary members of a global cluster report the current primary as their replication source,
+			// which changes when the global cluster switches over or fails over.
+			if v := aws.StringValue(dbc.ReplicationSourceIdentifier); v != "" && globalClusterHasMember(globalCluster, v) {
+				d.Set("replication_source_identifier", o)
+			}
 		} else if tfresource.NotFound(err) || tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Access Denied to API Version: APIGlobalDatabases") { //nolint:revive // Keep comments
 			// Ignore the following API error for regions/partitions that do not support RDS Global Clusters:
 			// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
@@ -1231,15 +1269,36 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
-	if d.HasChangesExcept(
+	// Engine version and parameter group changes are applied through a Blue/Green Deployment.
+	// All other changes are then made in place on the new (Green) cluster, which keeps the identifier.
+	blueGreen := d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(
+		"db_cluster_parameter_group_name",
+		"db_instance_parameter_group_name",
+		"engine_version",
+	)
+
+	if blueGreen {
+		if diags = append(diags, clusterBlueGreenUpdate(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))...); diags.HasError() {
+			return diags
+		}
+	}
+
+	modifyExcept := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"delete_automated_backups",
 		"final_snapshot_identifier",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		"tags", "tags_all") {
+		"tags", "tags_all",
+	}
+	if blueGreen {
+		modifyExcept = append(modifyExcept, "db_cluster_parameter_group_name", "db_instance_parameter_group_name", "engine_version")
+	}
+
+	if d.HasChangesExcept(modifyExcept...) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -1269,7 +1328,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if !blueGreen && d.HasChange("db_cluster_parameter_group_name") {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1278,7 +1337,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); !blueGreen && (ok || d.HasChange("db_instance_parameter_group_name")) {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
@@ -1305,14 +1364,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange("engine_version") {
+		if !blueGreen && d.HasChange("engine_version") {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
 
 		// This can happen when updates are deferred (apply_immediately = false), and
 		// multiple applies occur before the maintenance window. In this case,
 		// continue sending the desired engine_version as part of the modify request.
-		if d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) {
+		if !blueGreen && d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
 
@@ -1462,6 +1521,138 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+func clusterBlueGreenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+	deadline := tfresource.NewDeadline(timeout)
+
+	orchestrator := newBlueGreenOrchestrator(conn)
+	defer orchestrator.CleanUp(ctx)
+
+	handler := newClusterHandler(conn)
+
+	createIn := handler.createBlueGreenInput(d)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+
+	dep, err := orchestrator.CreateDeployment(ctx, createIn)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	// dep is nil if waiting for the deployment fails, so keep its identifier and last known status separately.
+	deploymentIdentifier, deploymentStatus := aws.StringValue(dep.BlueGreenDeploymentIdentifier), aws.StringValue(dep.Status)
+	defer func() {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+		// The switchover may have completed even if waiting for it failed.
+		if v, err := findBlueGreenDeploymentByID(ctx, conn, deploymentIdentifier); err == nil {
+			deploymentStatus = aws.StringValue(v.Status)
+		}
+
+		// Ensure that the Blue/Green Deployment, and the green cluster unless it has been switched over, are always cleaned up.
+		input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentIdentifier),
+		}
+		if deploymentStatus != "SWITCHOVER_COMPLETED" {
+			input.DeleteTarget = aws.Bool(true)
+		}
+		_, err = conn.DeleteBlueGreenDeployment(ctx, input)
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+			return
+		}
+
+		orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds_sdkv2.Client, optFns ...tfresource.OptionsFunc) {
+			_, err = waitBlueGreenDeploymentDeleted(ctx, conn, deploymentIdentifier, deadline.Remaining(), optFns...)
+			if err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
+			}
+		})
+	}()
+
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+	deploymentStatus = aws.StringValue(dep.Status)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+
+	dep, err = orchestrator.Switchover(ctx, deploymentIdentifier, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+	deploymentStatus = aws.StringValue(dep.Status)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
+
+	sourceARN, err := parseDBClusterARN(aws.StringValue(dep.Source))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	connV1 := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	source, err := FindDBClusterByID(ctx, connV1, sourceARN.Identifier)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	// The old (Blue) cluster's instances are renamed along with it and must be deleted before the cluster.
+	for _, member := range source.DBClusterMembers {
+		instanceID := aws.StringValue(member.DBInstanceIdentifier)
+
+		_, err := connV1.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+		if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source instance (%s): %s", d.Id(), instanceID, err)
+		}
+	}
+
+	for _, member := range source.DBClusterMembers {
+		instanceID := aws.StringValue(member.DBInstanceIdentifier)
+
+		if _, err := waitDBClusterInstanceDeleted(ctx, connV1, instanceID, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source instance (%s): waiting for completion: %s", d.Id(), instanceID, err)
+		}
+	}
+
+	if aws.BoolValue(source.DeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(sourceARN.Identifier),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if _, err := connV1.ModifyDBClusterWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: disabling deletion protection: %s", d.Id(), err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, connV1, sourceARN.Identifier, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: disabling deletion protection: %s", d.Id(), err)
+		}
+	}
+
+	_, err = connV1.DeleteDBClusterWithContext(ctx, &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(sourceARN.Identifier),
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	orchestrator.AddCleanupWaiter(func(ctx context.Context, _ *rds_sdkv2.Client, _ ...tfresource.OptionsFunc) {
+		_, err = waitDBClusterDeleted(ctx, connV1, sourceARN.Identifier, deadline.Remaining())
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
+		}
+	})
+
+	return diags
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
@@ -1612,6 +1803,31 @@ func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *r
 	compareActualEngineVersion(d, oldVersion, newVersion, pendingVersion)
 }
 
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexache.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
+
 func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
@@ -1774,3 +1990,10 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout
 
 	return nil, err
 }
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
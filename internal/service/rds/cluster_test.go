@@ -1376,6 +1376,45 @@ func TestAccRDSCluster_engineVersionWithPrimaryInstance(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_BlueGreenDeployment_updateParameterGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+	instanceResourceName := "aws_rds_cluster_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_parameterGroup(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_parameter_group_name", "aws_rds_cluster_parameter_group.test1", "name"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_parameterGroup(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_parameter_group_name", "aws_rds_cluster_parameter_group.test2", "name"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_identifier", rName),
+					resource.TestCheckResourceAttr(instanceResourceName, "identifier", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_GlobalClusterIdentifierEngineMode_global(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster1 rds.DBCluster
@@ -3402,6 +3441,64 @@ resource "aws_rds_cluster_instance" "test" {
 `, rName, upgrade)
 }
 
+func testAccClusterConfig_BlueGreenDeployment_parameterGroup(rName, parameterGroup string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine = "aurora-mysql"
+}
+
+resource "aws_rds_cluster_parameter_group" "test1" {
+  name   = "%[1]s-1"
+  family = data.aws_rds_engine_version.test.parameter_group_family
+
+  parameter {
+    name         = "binlog_format"
+    value        = "MIXED"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_rds_cluster_parameter_group" "test2" {
+  name   = "%[1]s-2"
+  family = data.aws_rds_engine_version.test.parameter_group_family
+
+  parameter {
+    name         = "binlog_format"
+    value        = "ROW"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.%[2]s.name
+  engine                          = data.aws_rds_engine_version.test.engine
+  engine_version                  = data.aws_rds_engine_version.test.version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+  apply_immediately               = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = aws_rds_cluster.test.engine
+  engine_version             = aws_rds_cluster.test.engine_version
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large"]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, rName, parameterGroup)
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -19,6 +19,7 @@ const (
 	ClusterStatusConfiguringIAMDatabaseAuth = "configuring-iam-database-auth"
 	ClusterStatusCreating                   = "creating"
 	ClusterStatusDeleting                   = "deleting"
+	ClusterStatusFailingOver                = "failing-over"
 	ClusterStatusMigrating                  = "migrating"
 	ClusterStatusModifying                  = "modifying"
 	ClusterStatusPreparingDataMigration     = "preparing-data-migration"
@@ -27,6 +28,9 @@ const (
 	ClusterStatusRenaming                   = "renaming"
 	ClusterStatusResettingMasterCredentials = "resetting-master-credentials"
 	ClusterStatusScalingCompute             = "scaling-compute"
+	ClusterStatusStarting                   = "starting"
+	ClusterStatusStopped                    = "stopped"
+	ClusterStatusStopping                   = "stopping"
 	ClusterStatusUpgrading                  = "upgrading"
 )
 
@@ -106,11 +110,13 @@ const (
 )
 
 const (
-	GlobalClusterStatusAvailable = "available"
-	GlobalClusterStatusCreating  = "creating"
-	GlobalClusterStatusDeleting  = "deleting"
-	GlobalClusterStatusModifying = "modifying"
-	GlobalClusterStatusUpgrading = "upgrading"
+	GlobalClusterStatusAvailable     = "available"
+	GlobalClusterStatusCreating      = "creating"
+	GlobalClusterStatusDeleting      = "deleting"
+	GlobalClusterStatusFailingOver   = "failing-over"
+	GlobalClusterStatusModifying     = "modifying"
+	GlobalClusterStatusSwitchingOver = "switching-over"
+	GlobalClusterStatusUpgrading     = "upgrading"
 )
 
 const (
@@ -5,7 +5,8 @@ package rds
 
 // Exports for use in tests only.
 var (
-	FindDBInstanceByID = findDBInstanceByIDSDKv1
+	FindDBInstanceByID   = findDBInstanceByIDSDKv1
+	FindIntegrationByARN = findIntegrationByARN
 
 	ListTags = listTags
 
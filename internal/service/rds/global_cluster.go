@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_rds_global_cluster")
@@ -104,6 +105,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"primary_db_cluster_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"source_db_cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -202,6 +209,10 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting global_cluster_members: %s", err)
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	// A global cluster has no writer until its first DB cluster is added.
+	if v := globalClusterWriterARN(globalCluster); v != "" {
+		d.Set("primary_db_cluster_identifier", v)
+	}
 	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
 
 	oldEngineVersion := d.Get("engine_version").(string)
@@ -238,6 +249,14 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("primary_db_cluster_identifier") {
+		if v := d.Get("primary_db_cluster_identifier").(string); v != "" {
+			if err := globalClusterSwitchover(ctx, meta, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Global Cluster (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Updating RDS Global Cluster (%s): %s", d.Id(), input)
 	_, err := conn.ModifyGlobalClusterWithContext(ctx, input)
 
@@ -387,15 +406,21 @@ func FindGlobalClusterByDBClusterARN(ctx context.Context, conn *rds.RDS, dbClust
 	}
 
 	return findGlobalCluster(ctx, conn, input, func(v *rds.GlobalCluster) bool {
-		for _, v := range v.GlobalClusterMembers {
-			if aws.StringValue(v.DBClusterArn) == dbClusterARN {
-				return true
-			}
-		}
-		return false
+		return globalClusterHasMember(v, dbClusterARN)
 	})
 }
 
+// globalClusterHasMember returns whether the specified DB Cluster is a member of the global cluster.
+func globalClusterHasMember(globalCluster *rds.GlobalCluster, dbClusterARN string) bool {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.StringValue(v.DBClusterArn) == dbClusterARN {
+			return true
+		}
+	}
+
+	return false
+}
+
 func FindGlobalClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.GlobalCluster, error) {
 	input := &rds.DescribeGlobalClustersInput{
 		GlobalClusterIdentifier: aws.String(id),
@@ -476,6 +501,16 @@ func flattenGlobalClusterMembers(apiObjects []*rds.GlobalClusterMember) []interf
 	return tfList
 }
 
+func globalClusterWriterARN(globalCluster *rds.GlobalCluster) string {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(v.IsWriter) {
+			return aws.StringValue(v.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
 func statusGlobalCluster(ctx context.Context, conn *rds.RDS, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindGlobalClusterByID(ctx, conn, id)
@@ -492,6 +527,32 @@ func statusGlobalCluster(ctx context.Context, conn *rds.RDS, id string) retry.St
 	}
 }
 
+// statusGlobalClusterSwitchover reports the global cluster's switchover progress.
+// The global cluster is only considered available once the target DB cluster has become the writer.
+func statusGlobalClusterSwitchover(ctx context.Context, conn *rds.RDS, id, targetARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.FailoverState; v != nil && aws.StringValue(v.Status) != "" {
+			return output, aws.StringValue(v.Status), nil
+		}
+
+		if status := aws.StringValue(output.Status); status != GlobalClusterStatusAvailable || globalClusterWriterARN(output) == targetARN {
+			return output, status, nil
+		}
+
+		return output, GlobalClusterStatusSwitchingOver, nil
+	}
+}
+
 func waitGlobalClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{GlobalClusterStatusCreating},
@@ -533,6 +594,45 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *rds.RDS, id string, tim
 	return err
 }
 
+func waitGlobalClusterSwitchedOver(ctx context.Context, conn *rds.RDS, id, targetARN string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			GlobalClusterStatusFailingOver,
+			GlobalClusterStatusModifying,
+			GlobalClusterStatusSwitchingOver,
+			rds.FailoverStatusPending,
+		},
+		Target:     []string{GlobalClusterStatusAvailable},
+		Refresh:    statusGlobalClusterSwitchover(ctx, conn, id, targetARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitDBClusterSwitchedOver(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			ClusterStatusBackingUp,
+			ClusterStatusFailingOver,
+			ClusterStatusModifying,
+			ClusterStatusRebooting,
+		},
+		Target:     []string{ClusterStatusAvailable},
+		Refresh:    statusDBCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func waitForGlobalClusterRemoval(ctx context.Context, conn *rds.RDS, dbClusterARN string, timeout time.Duration) error {
 	_, err := tfresource.RetryUntilNotFound(ctx, timeout, func() (interface{}, error) {
 		return FindGlobalClusterByDBClusterARN(ctx, conn, dbClusterARN)
@@ -541,6 +641,67 @@ func waitForGlobalClusterRemoval(ctx context.Context, conn *rds.RDS, dbClusterAR
 	return err
 }
 
+// globalClusterSwitchover promotes the specified secondary DB cluster to be the writer of an RDS Global Cluster
+// using a managed switchover, and waits for the global cluster and all of its member DB clusters to settle.
+func globalClusterSwitchover(ctx context.Context, meta interface{}, id, targetARN string, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+	deadline := tfresource.NewDeadline(timeout)
+
+	globalCluster, err := FindGlobalClusterByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Global Cluster (%s): %w", id, err)
+	}
+
+	// The DB cluster may already be the writer, for example after a switchover outside of Terraform.
+	if globalClusterWriterARN(globalCluster) == targetARN {
+		return nil
+	}
+
+	input := &rds.SwitchoverGlobalClusterInput{
+		GlobalClusterIdentifier:   aws.String(id),
+		TargetDbClusterIdentifier: aws.String(targetARN),
+	}
+
+	log.Printf("[INFO] Switching over RDS Global Cluster (%s) to RDS Cluster (%s)", id, targetARN)
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, deadline.Remaining(), func() (interface{}, error) {
+		return conn.SwitchoverGlobalClusterWithContext(ctx, input)
+	}, rds.ErrCodeInvalidDBClusterStateFault, rds.ErrCodeInvalidGlobalClusterStateFault)
+
+	if err != nil {
+		return fmt.Errorf("switching over to RDS Cluster (%s): %w", targetARN, err)
+	}
+
+	if err := waitGlobalClusterSwitchedOver(ctx, conn, id, targetARN, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for switchover to RDS Cluster (%s): %w", targetARN, err)
+	}
+
+	for _, clusterMember := range globalCluster.GlobalClusterMembers {
+		arnID := aws.StringValue(clusterMember.DBClusterArn)
+
+		if arnID == "" {
+			continue
+		}
+
+		_, clusterRegion, err := ClusterIDRegionFromARN(arnID)
+		if err != nil {
+			return fmt.Errorf("while switching over RDS Global Cluster: %w", err)
+		}
+
+		useConn := conn // clusters may not all be in the same region
+
+		if clusterRegion != meta.(*conns.AWSClient).Region {
+			useConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(clusterRegion))
+		}
+
+		if err := waitDBClusterSwitchedOver(ctx, useConn, arnID, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS Global Cluster member (%s) switchover: %w", arnID, err)
+		}
+	}
+
+	return nil
+}
+
 func globalClusterUpgradeMajorEngineVersion(ctx context.Context, meta interface{}, clusterID string, engineVersion string, timeout time.Duration) error {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_rds_instance_state", name="Instance State")
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceStateCreate,
+		ReadWithoutTimeout:   resourceInstanceStateRead,
+		UpdateWithoutTimeout: resourceInstanceStateUpdate,
+		DeleteWithoutTimeout: resourceInstanceStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{InstanceStatusAvailable, InstanceStatusStopped}, false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	id := d.Get("identifier").(string)
+
+	if err := updateDBInstanceState(ctx, conn, id, d.Get("state").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Instance State (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceInstanceStateRead(ctx, d, meta)...)
+}
+
+func resourceInstanceStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	v, err := findDBInstanceByIDSDKv1(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Instance State (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Instance State (%s): %s", d.Id(), err)
+	}
+
+	d.Set("identifier", v.DBInstanceIdentifier)
+	// RDS automatically starts an instance that has been stopped for seven days,
+	// which shows as drift from a configured "stopped" state.
+	d.Set("state", dbStateFromStatus(aws.StringValue(v.DBInstanceStatus)))
+
+	return diags
+}
+
+func resourceInstanceStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	if d.HasChange("state") {
+		if err := updateDBInstanceState(ctx, conn, d.Id(), d.Get("state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Instance State (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstanceStateRead(ctx, d, meta)...)
+}
+
+func resourceInstanceStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Deleting RDS Instance State (%s) only stops managing instance state, the instance is left in its current state", d.Id())
+
+	return nil // nosemgrep:ci.semgrep.pluginsdk.return-diags-not-nil
+}
+
+// updateDBInstanceState starts or stops an RDS DB Instance and waits for it to reach the configured state.
+func updateDBInstanceState(ctx context.Context, conn *rds.RDS, id, state string, timeout time.Duration) error {
+	return dbStateUpdater{
+		findStatus: func(ctx context.Context) (string, error) {
+			v, err := findDBInstanceByIDSDKv1(ctx, conn, id)
+
+			if err != nil {
+				return "", err
+			}
+
+			return aws.StringValue(v.DBInstanceStatus), nil
+		},
+		start: func(ctx context.Context) error {
+			_, err := conn.StartDBInstanceWithContext(ctx, &rds.StartDBInstanceInput{
+				DBInstanceIdentifier: aws.String(id),
+			})
+
+			return err
+		},
+		stop: func(ctx context.Context) error {
+			_, err := conn.StopDBInstanceWithContext(ctx, &rds.StopDBInstanceInput{
+				DBInstanceIdentifier: aws.String(id),
+			})
+
+			return err
+		},
+		waitStarted: func(ctx context.Context, timeout time.Duration) error {
+			_, err := waitDBInstanceStarted(ctx, conn, id, timeout)
+
+			return err
+		},
+		waitStopped: func(ctx context.Context, timeout time.Duration) error {
+			_, err := waitDBInstanceStopped(ctx, conn, id, timeout)
+
+			return err
+		},
+		stateFaultCode: rds.ErrCodeInvalidDBInstanceStateFault,
+	}.update(ctx, state, timeout)
+}
+
+func waitDBInstanceStopped(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			InstanceStatusAvailable,
+			InstanceStatusBackingUp,
+			InstanceStatusModifying,
+			InstanceStatusStarting,
+			InstanceStatusStopping,
+		},
+		Target:     []string{InstanceStatusStopped},
+		Refresh:    statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceStarted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			InstanceStatusBackingUp,
+			InstanceStatusModifying,
+			InstanceStatusStarting,
+			InstanceStatusStopped,
+			InstanceStatusStopping,
+		},
+		Target:     []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:    statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
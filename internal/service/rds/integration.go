@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_integration", name="Integration")
+// @Tags(identifierAttribute="arn")
+func ResourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntegrationCreate,
+		ReadWithoutTimeout:   resourceIntegrationRead,
+		UpdateWithoutTimeout: resourceIntegrationUpdate,
+		DeleteWithoutTimeout: resourceIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"integration_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	name := d.Get("integration_name").(string)
+	input := &rds_sdkv2.CreateIntegrationInput{
+		IntegrationName: aws.String(name),
+		SourceArn:       aws.String(d.Get("source_arn").(string)),
+		Tags:            getTagsInV2(ctx),
+		TargetArn:       aws.String(d.Get("target_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIntegration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Integration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.IntegrationArn))
+
+	if _, err := waitIntegrationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Integration (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIntegrationRead(ctx, d, meta)...)
+}
+
+func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	output, err := findIntegrationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Integration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", output.AdditionalEncryptionContext)
+	d.Set("arn", output.IntegrationArn)
+	d.Set("integration_name", output.IntegrationName)
+	d.Set("kms_key_id", output.KMSKeyId)
+	d.Set("source_arn", output.SourceArn)
+	d.Set("target_arn", output.TargetArn)
+
+	setTagsOutV2(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	log.Printf("[DEBUG] Deleting RDS Integration: %s", d.Id())
+	_, err := tfresource.RetryWhenIsA[*types.InvalidIntegrationStateFault](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteIntegration(ctx, &rds_sdkv2.DeleteIntegrationInput{
+			IntegrationIdentifier: aws.String(d.Id()),
+		})
+	})
+
+	if errs.IsA[*types.IntegrationNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Integration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitIntegrationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Integration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIntegrationByARN(ctx context.Context, conn *rds_sdkv2.Client, arn string) (*types.Integration, error) {
+	input := &rds_sdkv2.DescribeIntegrationsInput{
+		IntegrationIdentifier: aws.String(arn),
+	}
+
+	return findIntegration(ctx, conn, input)
+}
+
+func findIntegration(ctx context.Context, conn *rds_sdkv2.Client, input *rds_sdkv2.DescribeIntegrationsInput) (*types.Integration, error) {
+	output, err := findIntegrations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIntegrations(ctx context.Context, conn *rds_sdkv2.Client, input *rds_sdkv2.DescribeIntegrationsInput) ([]types.Integration, error) {
+	var output []types.Integration
+
+	pages := rds_sdkv2.NewDescribeIntegrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.IntegrationNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Integrations...)
+	}
+
+	return output, nil
+}
+
+func statusIntegration(ctx context.Context, conn *rds_sdkv2.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIntegrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIntegrationCreated(ctx context.Context, conn *rds_sdkv2.Client, arn string, timeout time.Duration) (*types.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.IntegrationStatusCreating, types.IntegrationStatusModifying),
+		Target:     enum.Slice(types.IntegrationStatusActive),
+		Refresh:    statusIntegration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *rds_sdkv2.Client, arn string, timeout time.Duration) (*types.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.IntegrationStatusActive, types.IntegrationStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusIntegration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func integrationError(apiObjects []types.IntegrationError) error {
+	var errList []error
+
+	for _, apiObject := range apiObjects {
+		errList = append(errList, fmt.Errorf("%s: %s", aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errList...)
+}
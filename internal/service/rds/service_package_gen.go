@@ -141,6 +141,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "rds",
+				ResourcePrefix: "pg:",
+			},
 		},
 		{
 			Factory:  ResourceProxy,
@@ -189,6 +193,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "rds",
+				ResourcePrefix: "subgrp:",
+			},
 		},
 		{
 			Factory:  ResourceCluster,
@@ -197,6 +205,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "rds",
+				ResourcePrefix: "cluster:",
+			},
 		},
 		{
 			Factory:  ResourceClusterActivityStream,
@@ -225,11 +237,20 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "rds",
+				ResourcePrefix: "cluster-pg:",
+			},
 		},
 		{
 			Factory:  ResourceClusterRoleAssociation,
 			TypeName: "aws_rds_cluster_role_association",
 		},
+		{
+			Factory:  ResourceClusterState,
+			TypeName: "aws_rds_cluster_state",
+			Name:     "Cluster State",
+		},
 		{
 			Factory:  ResourceCustomDBEngineVersion,
 			TypeName: "aws_rds_custom_db_engine_version",
@@ -242,6 +263,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalCluster,
 			TypeName: "aws_rds_global_cluster",
 		},
+		{
+			Factory:  ResourceInstanceState,
+			TypeName: "aws_rds_instance_state",
+			Name:     "Instance State",
+		},
+		{
+			Factory:  ResourceIntegration,
+			TypeName: "aws_rds_integration",
+			Name:     "Integration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceReservedInstance,
 			TypeName: "aws_rds_reserved_instance",
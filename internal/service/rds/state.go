@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// dbStateUpdater starts or stops an RDS DB Instance or DB Cluster, which share the status values used here.
+type dbStateUpdater struct {
+	findStatus  func(context.Context) (string, error)
+	start       func(context.Context) error
+	stop        func(context.Context) error
+	waitStarted func(context.Context, time.Duration) error
+	waitStopped func(context.Context, time.Duration) error
+	// The error code returned when starting or stopping is not valid in the current status.
+	stateFaultCode string
+}
+
+// update starts or stops the DB Instance or DB Cluster and waits for it to reach the specified state.
+// It is only started when it is stopped or stopping, and only stopped once it is available;
+// in any other status it is left to finish its current operation.
+func (u dbStateUpdater) update(ctx context.Context, state string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	status, err := u.findStatus(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	switch state {
+	case InstanceStatusAvailable:
+		if status == InstanceStatusStopped || status == InstanceStatusStopping {
+			// A stopping DB Instance or DB Cluster can't be started until it has stopped.
+			if err := u.retryWhileTransitional(ctx, deadline.Remaining(), u.start); err != nil {
+				return err
+			}
+		}
+
+		return u.waitStarted(ctx, deadline.Remaining())
+	case InstanceStatusStopped:
+		if status == InstanceStatusStopped {
+			return nil
+		}
+
+		if status != InstanceStatusStopping {
+			if status != InstanceStatusAvailable {
+				if err := u.waitStarted(ctx, deadline.Remaining()); err != nil {
+					return err
+				}
+			}
+
+			if err := u.retryWhileTransitional(ctx, deadline.Remaining(), u.stop); err != nil {
+				return err
+			}
+		}
+
+		return u.waitStopped(ctx, deadline.Remaining())
+	}
+
+	return nil
+}
+
+// retryWhileTransitional calls f, retrying state faults only while the current status is transitional.
+// Any other state fault, e.g. for a read replica, an instance optimizing storage or an engine that can't be stopped, is returned immediately.
+func (u dbStateUpdater) retryWhileTransitional(ctx context.Context, timeout time.Duration, f func(context.Context) error) error {
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return nil, f(ctx)
+		},
+		func(err error) (bool, error) {
+			if !tfawserr.ErrCodeEquals(err, u.stateFaultCode) {
+				return false, err
+			}
+
+			status, findErr := u.findStatus(ctx)
+
+			if findErr != nil {
+				return false, err
+			}
+
+			return isTransitionalDBStatus(status), err
+		},
+	)
+
+	return err
+}
+
+// isTransitionalDBStatus returns whether an RDS DB Instance or DB Cluster status is one that it leaves without intervention.
+func isTransitionalDBStatus(status string) bool {
+	switch status {
+	case InstanceStatusBackingUp, InstanceStatusModifying, InstanceStatusStarting, InstanceStatusStopping:
+		return true
+	default:
+		return false
+	}
+}
+
+// dbStateFromStatus maps an RDS DB Instance or DB Cluster status to the resource's "available" or "stopped" state.
+func dbStateFromStatus(status string) string {
+	switch status {
+	case InstanceStatusStopped, InstanceStatusStopping:
+		return InstanceStatusStopped
+	default:
+		return InstanceStatusAvailable
+	}
+}
@@ -61,6 +61,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "redshift",
+				ResourcePrefix: "cluster:",
+			},
 		},
 		{
 			Factory:  ResourceClusterIAMRoles,
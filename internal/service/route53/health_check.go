@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_route53_health_check", name="Health Check")
 // @Tags(identifierAttribute="id", resourceType="healthcheck")
+// @ARNImport(service="route53", resourcePrefix="healthcheck/")
 func ResourceHealthCheck() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHealthCheckCreate,
@@ -58,6 +58,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 				ResourceType:        "healthcheck",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "route53",
+				ResourcePrefix: "healthcheck/",
+			},
 		},
 		{
 			Factory:  ResourceHostedZoneDNSSEC,
@@ -95,6 +99,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 				ResourceType:        "hostedzone",
 			},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service:        "route53",
+				ResourcePrefix: "hostedzone/",
+			},
 		},
 		{
 			Factory:  ResourceZoneAssociation,
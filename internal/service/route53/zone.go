@@ -40,6 +40,7 @@ const (
 
 // @SDKResource("aws_route53_zone", name="Hosted Zone")
 // @Tags(identifierAttribute="id", resourceType="hostedzone")
+// @ARNImport(service="route53", resourcePrefix="hostedzone/")
 func ResourceZone() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneCreate,
@@ -52,6 +52,7 @@ const (
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
 // @Tags
+// @ARNImport(service="s3")
 func ResourceBucket() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketCreate,
@@ -32,6 +32,7 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	IsDirectoryBucket                     = isDirectoryBucket
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
+	VerifyObjectChecksums                 = verifyObjectChecksums
 
 	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
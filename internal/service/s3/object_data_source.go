@@ -5,8 +5,15 @@ package s3
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,14 +25,23 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// objectBodyBase64MaxSize caps the size of an object body that can be returned in body_base64.
+	objectBodyBase64MaxSize = 100 * 1024 * 1024
+)
+
 // @SDKDataSource("aws_s3_object")
 func DataSourceObject() *schema.Resource {
 	return &schema.Resource{
@@ -36,6 +52,16 @@ func DataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"body_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"body_base64_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, objectBodyBase64MaxSize),
+			},
 			"bucket": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -101,6 +127,11 @@ func DataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fetch_object_attributes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -114,6 +145,74 @@ func DataSourceObject() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"object_attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checksum_crc32": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum_crc32c": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum_sha1": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checksum_sha256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"parts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"checksum_crc32": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"checksum_crc32c": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"checksum_sha1": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"checksum_sha256": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"part_number": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"size": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_parts_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"object_lock_legal_hold_status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -240,7 +339,31 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("version_id", output.VersionId)
 	d.Set("website_redirect_location", output.WebsiteRedirectLocation)
 
-	if isContentTypeAllowed(output.ContentType) {
+	var parts []types.ObjectPart
+	if d.Get("fetch_object_attributes").(bool) {
+		attributes, err := findObjectAttributes(ctx, conn, bucket, key, aws.ToString(output.VersionId), optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) attributes: %s", bucket, key, err)
+		}
+
+		if err := d.Set("object_attributes", []interface{}{flattenObjectAttributes(attributes)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting object_attributes: %s", err)
+		}
+
+		if attributes.ObjectParts != nil {
+			parts = attributes.ObjectParts.Parts
+		}
+	} else {
+		d.Set("object_attributes", nil)
+	}
+
+	maxSize := int64(d.Get("body_base64_max_size").(int))
+	if isContentTypeAllowed(output.ContentType) || maxSize > 0 {
+		if maxSize > 0 && aws.ToInt64(output.ContentLength) > maxSize {
+			return sdkdiag.AppendErrorf(diags, "S3 Bucket (%s) Object (%s) size (%d) exceeds body_base64_max_size (%d)", bucket, key, aws.ToInt64(output.ContentLength), maxSize)
+		}
+
 		downloader := manager.NewDownloader(conn, manager.WithDownloaderClientOptions(optFns...))
 		buf := manager.NewWriteAtBuffer(make([]byte, 0))
 		input := &s3.GetObjectInput{
@@ -258,7 +381,47 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "downloading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
 		}
 
-		d.Set("body", string(buf.Bytes()))
+		body := buf.Bytes()
+
+		checksumEnabled := types.ChecksumMode(d.Get("checksum_mode").(string)) == types.ChecksumModeEnabled
+
+		// Stored checksums are of the whole object, so a body read with a byte range cannot be verified.
+		if checksumEnabled && input.Range != nil {
+			diags = sdkdiag.AppendWarningf(diags, "S3 Bucket (%s) Object (%s) was read with a byte range; body was not verified", bucket, key)
+		}
+
+		// The downloader uses ranged GETs, for which the SDK does not validate checksums, so verify the whole body here.
+		if checksumEnabled && input.Range == nil {
+			// Composite checksums of multipart objects are verified against the part checksums.
+			if parts == nil && tfslices.Any([]*string{output.ChecksumCRC32, output.ChecksumCRC32C, output.ChecksumSHA1, output.ChecksumSHA256}, func(v *string) bool {
+				return strings.Contains(aws.ToString(v), "-")
+			}) {
+				attributes, err := findObjectAttributes(ctx, conn, bucket, key, aws.ToString(output.VersionId), optFns...)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) attributes: %s", bucket, key, err)
+				}
+
+				if attributes.ObjectParts != nil {
+					parts = attributes.ObjectParts.Parts
+				}
+			}
+
+			if verified, err := verifyObjectChecksums(body, output, parts); err != nil {
+				return sdkdiag.AppendErrorf(diags, "verifying S3 Bucket (%s) Object (%s) checksum: %s", bucket, key, err)
+			} else if !verified {
+				diags = sdkdiag.AppendWarningf(diags, "S3 Bucket (%s) Object (%s) has no stored checksum; body was not verified", bucket, key)
+			}
+		}
+
+		if isContentTypeAllowed(output.ContentType) {
+			d.Set("body", string(body))
+		}
+		if maxSize > 0 {
+			d.Set("body_base64", base64.StdEncoding.EncodeToString(body))
+		}
+	} else {
+		diags = sdkdiag.AppendWarningf(diags, "S3 Bucket (%s) Object (%s) Content-Type (%s) is not human-readable and body_base64_max_size is not set; neither body nor body_base64 is set", bucket, key, aws.ToString(output.ContentType))
 	}
 
 	if tags, err := ObjectListTags(ctx, conn, bucket, key, optFns...); err == nil {
@@ -298,3 +461,161 @@ func isContentTypeAllowed(contentType *string) bool {
 
 	return false
 }
+
+func findObjectAttributes(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) (*s3.GetObjectAttributesOutput, error) {
+	input := &s3.GetObjectAttributesInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		ObjectAttributes: []types.ObjectAttributes{
+			types.ObjectAttributesChecksum,
+			types.ObjectAttributesObjectParts,
+			types.ObjectAttributesObjectSize,
+			types.ObjectAttributesStorageClass,
+		},
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	var output *s3.GetObjectAttributesOutput
+	for {
+		page, err := conn.GetObjectAttributes(ctx, input, optFns...)
+
+		if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if output == nil {
+			output = page
+		} else if page.ObjectParts != nil {
+			output.ObjectParts.Parts = append(output.ObjectParts.Parts, page.ObjectParts.Parts...)
+		}
+
+		if page.ObjectParts == nil || !aws.ToBool(page.ObjectParts.IsTruncated) {
+			break
+		}
+
+		input.PartNumberMarker = page.ObjectParts.NextPartNumberMarker
+	}
+
+	return output, nil
+}
+
+func flattenObjectAttributes(apiObject *s3.GetObjectAttributesOutput) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"object_size": aws.ToInt64(apiObject.ObjectSize),
+		// The "STANDARD" storage class is not included in the results.
+		"storage_class": types.StorageClassStandard,
+	}
+
+	if v := apiObject.StorageClass; v != "" {
+		tfMap["storage_class"] = v
+	}
+
+	if v := apiObject.Checksum; v != nil {
+		tfMap["checksum_crc32"] = aws.ToString(v.ChecksumCRC32)
+		tfMap["checksum_crc32c"] = aws.ToString(v.ChecksumCRC32C)
+		tfMap["checksum_sha1"] = aws.ToString(v.ChecksumSHA1)
+		tfMap["checksum_sha256"] = aws.ToString(v.ChecksumSHA256)
+	}
+
+	if v := apiObject.ObjectParts; v != nil {
+		tfMap["total_parts_count"] = aws.ToInt32(v.TotalPartsCount)
+
+		var tfList []interface{}
+		for _, apiObject := range v.Parts {
+			tfList = append(tfList, map[string]interface{}{
+				"checksum_crc32":  aws.ToString(apiObject.ChecksumCRC32),
+				"checksum_crc32c": aws.ToString(apiObject.ChecksumCRC32C),
+				"checksum_sha1":   aws.ToString(apiObject.ChecksumSHA1),
+				"checksum_sha256": aws.ToString(apiObject.ChecksumSHA256),
+				"part_number":     aws.ToInt32(apiObject.PartNumber),
+				"size":            aws.ToInt64(apiObject.Size),
+			})
+		}
+		tfMap["parts"] = tfList
+	}
+
+	return tfMap
+}
+
+// verifyObjectChecksums verifies an object's body against each of its stored checksums.
+// Checksums of multipart objects are composite ("<checksum>-<parts>") and are verified part by part.
+// Returns false if the object has no stored checksum.
+func verifyObjectChecksums(body []byte, output *s3.HeadObjectOutput, parts []types.ObjectPart) (bool, error) {
+	checksums := []struct {
+		algorithm types.ChecksumAlgorithm
+		value     *string
+		part      func(types.ObjectPart) *string
+		new       func() hash.Hash
+	}{
+		{types.ChecksumAlgorithmCrc32, output.ChecksumCRC32, func(v types.ObjectPart) *string { return v.ChecksumCRC32 }, func() hash.Hash { return crc32.NewIEEE() }},
+		{types.ChecksumAlgorithmCrc32c, output.ChecksumCRC32C, func(v types.ObjectPart) *string { return v.ChecksumCRC32C }, func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Castagnoli)) }},
+		{types.ChecksumAlgorithmSha1, output.ChecksumSHA1, func(v types.ObjectPart) *string { return v.ChecksumSHA1 }, sha1.New},
+		{types.ChecksumAlgorithmSha256, output.ChecksumSHA256, func(v types.ObjectPart) *string { return v.ChecksumSHA256 }, sha256.New},
+	}
+
+	verified := false
+	for _, checksum := range checksums {
+		want := aws.ToString(checksum.value)
+		if want == "" {
+			continue
+		}
+
+		digest := func(b []byte) []byte {
+			h := checksum.new()
+			h.Write(b)
+			return h.Sum(nil)
+		}
+
+		want, n, composite := strings.Cut(want, "-")
+		if !composite {
+			if got := base64.StdEncoding.EncodeToString(digest(body)); got != want {
+				return false, fmt.Errorf("%s checksum mismatch: got %s, want %s", checksum.algorithm, got, want)
+			}
+
+			verified = true
+			continue
+		}
+
+		if n != strconv.Itoa(len(parts)) {
+			return false, fmt.Errorf("%s checksum covers %s parts, got %d parts", checksum.algorithm, n, len(parts))
+		}
+
+		var offset int64
+		var digests []byte
+		for _, part := range parts {
+			size := aws.ToInt64(part.Size)
+			if offset+size > int64(len(body)) {
+				return false, fmt.Errorf("part %d extends beyond the object body", aws.ToInt32(part.PartNumber))
+			}
+
+			d := digest(body[offset : offset+size])
+			if got, want := base64.StdEncoding.EncodeToString(d), aws.ToString(checksum.part(part)); got != want {
+				return false, fmt.Errorf("part %d %s checksum mismatch: got %s, want %s", aws.ToInt32(part.PartNumber), checksum.algorithm, got, want)
+			}
+
+			digests = append(digests, d...)
+			offset += size
+		}
+
+		if got := base64.StdEncoding.EncodeToString(digest(digests)); got != want {
+			return false, fmt.Errorf("%s checksum mismatch: got %s, want %s", checksum.algorithm, got, want)
+		}
+
+		verified = true
+	}
+
+	return verified, nil
+}
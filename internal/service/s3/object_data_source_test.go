@@ -4,19 +4,100 @@
 package s3_test
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const rfc1123RegexPattern = `^[A-Za-z]{3}, [0-9]+ [A-Za-z]+ [0-9]{4} [0-9:]+ [A-Z]+$`
 
+func TestVerifyObjectChecksums(t *testing.T) {
+	t.Parallel()
+
+	sha256Sum := func(b []byte) []byte {
+		v := sha256.Sum256(b)
+		return v[:]
+	}
+	b64 := base64.StdEncoding.EncodeToString
+
+	body := []byte("Keep Calm and Carry On")
+	part1, part2 := body[:10], body[10:]
+	composite := b64(sha256Sum(append(sha256Sum(part1), sha256Sum(part2)...))) + "-2"
+	parts := []types.ObjectPart{
+		{ChecksumSHA256: aws.String(b64(sha256Sum(part1))), PartNumber: aws.Int32(1), Size: aws.Int64(int64(len(part1)))},
+		{ChecksumSHA256: aws.String(b64(sha256Sum(part2))), PartNumber: aws.Int32(2), Size: aws.Int64(int64(len(part2)))},
+	}
+
+	testCases := []struct {
+		name         string
+		output       *s3.HeadObjectOutput
+		parts        []types.ObjectPart
+		wantVerified bool
+		wantErr      bool
+	}{
+		{
+			name:   "no checksum",
+			output: &s3.HeadObjectOutput{},
+		},
+		{
+			name:         "crc32",
+			output:       &s3.HeadObjectOutput{ChecksumCRC32: aws.String("bCUlIA==")},
+			wantVerified: true,
+		},
+		{
+			name:         "sha256",
+			output:       &s3.HeadObjectOutput{ChecksumSHA256: aws.String(b64(sha256Sum(body)))},
+			wantVerified: true,
+		},
+		{
+			name:    "sha256 mismatch",
+			output:  &s3.HeadObjectOutput{ChecksumSHA256: aws.String(b64(sha256Sum([]byte("Keep Calm"))))},
+			wantErr: true,
+		},
+		{
+			name:         "composite sha256",
+			output:       &s3.HeadObjectOutput{ChecksumSHA256: aws.String(composite)},
+			parts:        parts,
+			wantVerified: true,
+		},
+		{
+			name:    "composite sha256 missing parts",
+			output:  &s3.HeadObjectOutput{ChecksumSHA256: aws.String(composite)},
+			parts:   parts[:1],
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			verified, err := tfs3.VerifyObjectChecksums(body, testCase.output, testCase.parts)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("VerifyObjectChecksums() err %t, want %t: %v", got, want, err)
+			}
+
+			if got, want := verified, testCase.wantVerified; got != want {
+				t.Errorf("VerifyObjectChecksums() verified %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccS3ObjectDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -403,6 +484,63 @@ func TestAccS3ObjectDataSource_checksumMode(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_bodyBase64(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_bodyBase64(rName, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "body", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "body_base64", resourceName, "content_base64"),
+					resource.TestCheckResourceAttr(dataSourceName, "body_base64_max_size", "1024"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_type", "application/zip"),
+				),
+			},
+			{
+				Config:      testAccObjectDataSourceConfig_bodyBase64(rName, 4),
+				ExpectError: regexache.MustCompile(`exceeds body_base64_max_size`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_objectAttributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_objectAttributes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "body", "Keep Calm and Carry On"),
+					resource.TestCheckResourceAttr(dataSourceName, "fetch_object_attributes", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_attributes.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "object_attributes.0.checksum_sha256", resourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_attributes.0.object_size", "22"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_attributes.0.parts.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_attributes.0.storage_class", "STANDARD"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_metadata(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -832,6 +970,53 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_bodyBase64(rName string, maxSize int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key            = "%[1]s-key"
+  content_base64 = "UEsFBgAAAAAAAAAAAAAAAAAAAAAAAA=="
+  content_type   = "application/zip"
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = aws_s3_object.test.key
+
+  body_base64_max_size = %[2]d
+}
+`, rName, maxSize)
+}
+
+func testAccObjectDataSourceConfig_objectAttributes(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "Keep Calm and Carry On"
+  content_type = "text/plain"
+
+  checksum_algorithm = "SHA256"
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = aws_s3_object.test.key
+
+  checksum_mode           = "ENABLED"
+  fetch_object_attributes = true
+}
+`, rName)
+}
+
 func testAccObjectDataSourceConfig_metadata(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -0,0 +1,787 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_objects_sync", name="Objects Sync")
+func ResourceObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectsSyncCreate,
+		ReadWithoutTimeout:   resourceObjectsSyncRead,
+		UpdateWithoutTimeout: resourceObjectsSyncUpdate,
+		DeleteWithoutTimeout: resourceObjectsSyncDelete,
+
+		CustomizeDiff: resourceObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allow_bucket_prune": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"content_type_default": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "application/octet-stream",
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				// A prefix that does not end in a slash would also match the keys of sibling "folders", e.g. "assets" matches "assets-old/".
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^$|/$`), "must end with a slash (/)"),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"prune": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateMetadataIsLowerCase,
+						},
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								if _, err := path.Match(v.(string), ""); err != nil {
+									errors = append(errors, fmt.Errorf("%q: %w", k, err))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_class": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StorageClass](),
+			},
+		},
+	}
+}
+
+func resourceObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := syncObjects(ctx, d, meta, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync: %s", err)
+	}
+
+	d.SetId(objectsSyncCreateResourceID(d.Get("bucket").(string), d.Get("key_prefix").(string)))
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := objectsSyncClient(ctx, d, meta)
+
+	bucket, prefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+
+	if err := findBucket(ctx, conn, bucket); !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Objects Sync (%s) bucket not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	} else if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	manifest, err := buildObjectsSyncManifest(d)
+
+	// The planned manifest hash reports any change to a missing source directory.
+	if errors.Is(err, fs.ErrNotExist) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	remote, err := listObjectsSyncRemoteObjects(ctx, conn, bucket, prefix)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	// Cheap drift detection: any missing or resized object, or any stray object when pruning,
+	// clears the manifest hash so that the next plan re-synchronizes.
+	drifted := false
+	for key, entry := range manifest.entries {
+		if size, ok := remote[key]; !ok || size != entry.size {
+			drifted = true
+			break
+		}
+	}
+	if d.Get("prune").(bool) && len(remote) != len(manifest.entries) {
+		drifted = true
+	}
+
+	if drifted {
+		d.Set("manifest_hash", "")
+	}
+
+	return diags
+}
+
+func resourceObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Any change to the upload settings requires every object to be uploaded again.
+	force := d.HasChanges("content_type_default", "kms_key_id", "rule", "server_side_encryption", "storage_class")
+
+	if err := syncObjects(ctx, d, meta, force); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := objectsSyncClient(ctx, d, meta)
+
+	bucket, prefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+
+	var keys []string
+	if d.Get("prune").(bool) && (prefix != "" || d.Get("allow_bucket_prune").(bool)) {
+		// The resource owns the whole prefix.
+		remote, err := listObjectsSyncRemoteObjects(ctx, conn, bucket, prefix)
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+		}
+
+		for key := range remote {
+			keys = append(keys, key)
+		}
+	} else {
+		manifest, err := buildObjectsSyncManifest(d)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Printf("[WARN] S3 Objects Sync (%s) source_dir not found, leaving objects in place: %s", d.Id(), err)
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+		}
+
+		for key := range manifest.entries {
+			keys = append(keys, key)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Objects Sync (%s): %d objects", d.Id(), len(keys))
+	if err := deleteObjectsSyncObjects(ctx, conn, bucket, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// With no key prefix, pruning deletes every other object in the bucket, and destroying the resource empties the bucket.
+	if d.Get("prune").(bool) && d.Get("key_prefix").(string) == "" && !d.Get("allow_bucket_prune").(bool) {
+		return errors.New("`prune` with an empty `key_prefix` deletes objects from the whole bucket; set `allow_bucket_prune` to `true` to confirm")
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("rule") {
+		return d.SetNewComputed("manifest_hash")
+	}
+
+	manifest, err := buildObjectsSyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	if hash := manifest.hash(); d.Get("manifest_hash").(string) != hash {
+		return d.SetNew("manifest_hash", hash)
+	}
+
+	return nil
+}
+
+func objectsSyncClient(ctx context.Context, d *schema.ResourceData, meta interface{}) *s3.Client {
+	if isDirectoryBucket(d.Get("bucket").(string)) {
+		return meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	return meta.(*conns.AWSClient).S3Client(ctx)
+}
+
+// syncObjects uploads new and changed files and, if configured, deletes stray objects.
+// When force is true every file is uploaded regardless of its remote checksum.
+func syncObjects(ctx context.Context, d *schema.ResourceData, meta interface{}, force bool) error {
+	conn := objectsSyncClient(ctx, d, meta)
+	bucket, prefix := d.Get("bucket").(string), d.Get("key_prefix").(string)
+
+	manifest, err := buildObjectsSyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := listObjectsSyncRemoteObjects(ctx, conn, bucket, prefix)
+
+	if err != nil {
+		return err
+	}
+
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.PartSize = manager.DefaultUploadPartSize
+	})
+
+	// ResourceData is not safe for concurrent use, so read all settings before starting uploads.
+	opts := objectsSyncUploadOptions{
+		bucket:               bucket,
+		force:                force,
+		kmsKeyID:             d.Get("kms_key_id").(string),
+		serverSideEncryption: types.ServerSideEncryption(d.Get("server_side_encryption").(string)),
+		storageClass:         types.StorageClass(d.Get("storage_class").(string)),
+	}
+
+	var (
+		errs   []error
+		mu     sync.Mutex
+		sem    = make(chan struct{}, d.Get("parallelism").(int))
+		wg     sync.WaitGroup
+		upload = func(entry *objectsSyncEntry) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := uploadObjectsSyncEntry(ctx, conn, uploader, opts, entry, remote)
+
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}
+	)
+
+	for _, key := range manifest.keys() {
+		sem <- struct{}{}
+		wg.Add(1)
+		go upload(manifest.entries[key])
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	if d.Get("prune").(bool) {
+		var stray []string
+		for key := range remote {
+			if _, ok := manifest.entries[key]; !ok {
+				stray = append(stray, key)
+			}
+		}
+
+		if err := deleteObjectsSyncObjects(ctx, conn, bucket, stray); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// objectsSyncUploadOptions are the settings shared by all uploads in a sync.
+type objectsSyncUploadOptions struct {
+	bucket               string
+	force                bool
+	kmsKeyID             string
+	serverSideEncryption types.ServerSideEncryption
+	storageClass         types.StorageClass
+}
+
+func uploadObjectsSyncEntry(ctx context.Context, conn *s3.Client, uploader *manager.Uploader, opts objectsSyncUploadOptions, entry *objectsSyncEntry, remote map[string]int64) error {
+	bucket := opts.bucket
+
+	if size, ok := remote[entry.key]; ok && size == entry.size && !opts.force {
+		output, err := conn.HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket:       aws.String(bucket),
+			ChecksumMode: types.ChecksumModeEnabled,
+			Key:          aws.String(entry.key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (%s) checksum: %w", entry.key, err)
+		}
+
+		if aws.ToString(output.ChecksumSHA256) == entry.checksum {
+			return nil
+		}
+	}
+
+	file, err := os.Open(entry.path)
+
+	if err != nil {
+		return fmt.Errorf("opening S3 object source (%s): %w", entry.path, err)
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", entry.path, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Body:              file,
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ContentType:       aws.String(entry.contentType),
+		Key:               aws.String(entry.key),
+	}
+
+	if entry.cacheControl != "" {
+		input.CacheControl = aws.String(entry.cacheControl)
+	}
+
+	if entry.contentEncoding != "" {
+		input.ContentEncoding = aws.String(entry.contentEncoding)
+	}
+
+	if len(entry.metadata) > 0 {
+		input.Metadata = entry.metadata
+	}
+
+	if v := opts.kmsKeyID; v != "" {
+		input.SSEKMSKeyId = aws.String(v)
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+
+	if v := opts.serverSideEncryption; v != "" {
+		input.ServerSideEncryption = v
+	}
+
+	if v := opts.storageClass; v != "" {
+		input.StorageClass = v
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", entry.key, bucket, err)
+	}
+
+	return nil
+}
+
+func listObjectsSyncRemoteObjects(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]int64, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	output := make(map[string]int64)
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range page.Contents {
+			output[aws.ToString(v.Key)] = aws.ToInt64(v.Size)
+		}
+	}
+
+	return output, nil
+}
+
+func deleteObjectsSyncObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	const (
+		batchSize = 1000
+	)
+
+	sort.Strings(keys)
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > batchSize {
+			n = batchSize
+		}
+		page := &s3.ListObjectsV2Output{}
+		for _, key := range keys[:n] {
+			page.Contents = append(page.Contents, types.Object{Key: aws.String(key)})
+		}
+		keys = keys[n:]
+
+		if _, err := deletePageOfObjects(ctx, conn, bucket, page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type objectsSyncEntry struct {
+	cacheControl    string
+	checksum        string
+	contentEncoding string
+	contentType     string
+	key             string
+	metadata        map[string]string
+	path            string
+	size            int64
+}
+
+type objectsSyncManifest struct {
+	entries map[string]*objectsSyncEntry
+}
+
+func (m *objectsSyncManifest) keys() []string {
+	keys := make([]string, 0, len(m.entries))
+	for key := range m.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// hash returns a stable digest of every object key, checksum and upload setting in the manifest.
+func (m *objectsSyncManifest) hash() string {
+	h := sha256.New()
+
+	for _, key := range m.keys() {
+		entry := m.entries[key]
+		metadataKeys := make([]string, 0, len(entry.metadata))
+		for k := range entry.metadata {
+			metadataKeys = append(metadataKeys, k)
+		}
+		sort.Strings(metadataKeys)
+
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s", key, entry.checksum, entry.cacheControl, entry.contentEncoding, entry.contentType)
+		for _, k := range metadataKeys {
+			fmt.Fprintf(h, "\x00%s=%s", k, entry.metadata[k])
+		}
+		fmt.Fprint(h, "\n")
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type objectsSyncRule struct {
+	cacheControl    string
+	contentEncoding string
+	contentType     string
+	metadata        map[string]string
+	pattern         string
+}
+
+func (r *objectsSyncRule) matches(relPath string) bool {
+	name := relPath
+	// Patterns without a separator match against the file name in any directory.
+	if !strings.Contains(r.pattern, "/") {
+		name = path.Base(relPath)
+	}
+
+	ok, _ := path.Match(r.pattern, name)
+
+	return ok
+}
+
+type resourceGetter interface {
+	Get(string) interface{}
+}
+
+func buildObjectsSyncManifest(d resourceGetter) (*objectsSyncManifest, error) {
+	source := d.Get("source_dir").(string)
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", source, err)
+	}
+
+	prefix := d.Get("key_prefix").(string)
+	defaultContentType := d.Get("content_type_default").(string)
+
+	var rules []*objectsSyncRule
+	for _, tfMapRaw := range d.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := &objectsSyncRule{
+			cacheControl:    tfMap["cache_control"].(string),
+			contentEncoding: tfMap["content_encoding"].(string),
+			contentType:     tfMap["content_type"].(string),
+			pattern:         tfMap["pattern"].(string),
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok && len(v) > 0 {
+			rule.metadata = flex.ExpandStringValueMap(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	manifest := &objectsSyncManifest{
+		entries: make(map[string]*objectsSyncEntry),
+	}
+
+	err = filepath.WalkDir(root, func(p string, de fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !de.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		relPath := filepath.ToSlash(rel)
+		entry := &objectsSyncEntry{
+			key:  sdkv1CompatibleCleanKey(prefix + relPath),
+			path: p,
+		}
+
+		if entry.size, entry.checksum, err = objectsSyncFileChecksum(p); err != nil {
+			return err
+		}
+
+		// All matching rules apply in order; later rules override earlier ones.
+		for _, rule := range rules {
+			if !rule.matches(relPath) {
+				continue
+			}
+
+			if rule.cacheControl != "" {
+				entry.cacheControl = rule.cacheControl
+			}
+			if rule.contentEncoding != "" {
+				entry.contentEncoding = rule.contentEncoding
+			}
+			if rule.contentType != "" {
+				entry.contentType = rule.contentType
+			}
+			for k, v := range rule.metadata {
+				if entry.metadata == nil {
+					entry.metadata = make(map[string]string)
+				}
+				entry.metadata[k] = v
+			}
+		}
+
+		if entry.contentType == "" {
+			if entry.contentType, err = detectObjectsSyncContentType(p, defaultContentType); err != nil {
+				return err
+			}
+		}
+
+		manifest.entries[entry.key] = entry
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", root, err)
+	}
+
+	return manifest, nil
+}
+
+// objectsSyncFileChecksum returns a file's size and the SHA-256 checksum that S3 reports for it
+// when uploaded by manager.Uploader. Multipart uploads have a composite checksum of the part checksums.
+func objectsSyncFileChecksum(p string) (int64, string, error) {
+	file, err := os.Open(p)
+
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+
+	if err != nil {
+		return 0, "", err
+	}
+
+	size := info.Size()
+	partSize := int64(manager.DefaultUploadPartSize)
+	// Mirror the uploader's part size adjustment for very large files.
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = (size / int64(manager.MaxUploadParts)) + 1
+	}
+
+	// The uploader uses a single PutObject request unless the file is larger than one part.
+	if size <= partSize {
+		h := sha256.New()
+		if _, err := io.Copy(h, file); err != nil {
+			return 0, "", err
+		}
+
+		return size, base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	composite := sha256.New()
+	var n int
+	for {
+		h := sha256.New()
+		written, err := io.CopyN(h, file, partSize)
+
+		if written > 0 {
+			composite.Write(h.Sum(nil))
+			n++
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return 0, "", err
+		}
+	}
+
+	return size, fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(composite.Sum(nil)), n), nil
+}
+
+func detectObjectsSyncContentType(p, defaultContentType string) (string, error) {
+	if v := mime.TypeByExtension(filepath.Ext(p)); v != "" {
+		return v, nil
+	}
+
+	file, err := os.Open(p)
+
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if n == 0 {
+		return defaultContentType, nil
+	}
+
+	// http.DetectContentType falls back to "application/octet-stream" for unrecognized data.
+	if v := http.DetectContentType(buf[:n]); v != "application/octet-stream" {
+		return v, nil
+	}
+
+	return defaultContentType, nil
+}
+
+const objectsSyncResourceIDSeparator = "/"
+
+func objectsSyncCreateResourceID(bucket, prefix string) string {
+	return strings.Join([]string{bucket, prefix}, objectsSyncResourceIDSeparator)
+}
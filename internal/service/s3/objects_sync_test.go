@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ObjectsSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectsSyncCreateSourceDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/site.css":   "body {}",
+		"data/blob.bin":  "\x00\x01\x02",
+		"data/notes.txt": "hello",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					resource.TestCheckResourceAttr(resourceName, "prune", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					testAccCheckObjectsSyncObject(ctx, rName, "site/index.html", "text/html; charset=utf-8", "max-age=60"),
+					testAccCheckObjectsSyncObject(ctx, rName, "site/css/site.css", "text/css; charset=utf-8", "max-age=3600"),
+					testAccCheckObjectsSyncObject(ctx, rName, "site/data/blob.bin", "application/octet-stream", ""),
+					testAccCheckObjectsSyncObject(ctx, rName, "site/data/notes.txt", "text/plain; charset=utf-8", ""),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccObjectsSyncWriteFile(t, source, "index.html", "<html><body></body></html>")
+				},
+				Config: testAccObjectsSyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncObjectBody(ctx, rName, "site/index.html", "<html><body></body></html>"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_prune(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectsSyncCreateSourceDir(t, map[string]string{
+		"a.txt": "a",
+		"b.txt": "b",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_prune(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prune", "true"),
+					testAccCheckObjectsSyncObjectBody(ctx, rName, "a.txt", "a"),
+					testAccCheckObjectsSyncObjectBody(ctx, rName, "b.txt", "b"),
+					testAccCheckObjectsSyncObjectBody(ctx, rName, "stray.txt", "stray"),
+				),
+				// The stray object is pruned on the next apply.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "b.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsSyncConfig_pruneNoStray(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsSyncObjectBody(ctx, rName, "a.txt", "a"),
+					testAccCheckObjectsSyncObjectNotExists(ctx, rName, "b.txt"),
+					testAccCheckObjectsSyncObjectNotExists(ctx, rName, "stray.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_pruneBucketNotAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectsSyncCreateSourceDir(t, map[string]string{
+		"a.txt": "a",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectsSyncConfig_pruneBucketNotAllowed(rName, source),
+				ExpectError: regexache.MustCompile("set `allow_bucket_prune` to `true` to confirm"),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectsSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_objects_sync" {
+				continue
+			}
+
+			output, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+			})
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				// The bucket may already have been destroyed.
+				continue
+			}
+
+			if n := len(output.Contents); n > 0 {
+				return fmt.Errorf("S3 Objects Sync %s still has %d objects", rs.Primary.ID, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObject(ctx context.Context, bucket, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) ContentType = %v, want %v", key, got, contentType)
+		}
+
+		if got := aws.ToString(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) CacheControl = %v, want %v", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObjectBody(ctx context.Context, bucket, key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := conn.GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return testAccCheckObjectBody(output, want)(s)
+	}
+}
+
+func testAccCheckObjectsSyncObjectNotExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccObjectsSyncCreateSourceDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		testAccObjectsSyncWriteFile(t, dir, name, content)
+	}
+
+	return dir
+}
+
+func testAccObjectsSyncWriteFile(t *testing.T, dir, name, content string) {
+	p := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccObjectsSyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+
+  rule {
+    pattern       = "*.html"
+    cache_control = "max-age=60"
+  }
+
+  rule {
+    pattern       = "css/*"
+    cache_control = "max-age=3600"
+
+    metadata = {
+      "asset" = "true"
+    }
+  }
+}
+`, rName, source)
+}
+
+func testAccObjectsSyncConfig_prune(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "stray" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "stray.txt"
+  content = "stray"
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[2]q
+  prune      = true
+
+  allow_bucket_prune = true
+
+  depends_on = [aws_s3_object.stray]
+}
+`, rName, source)
+}
+
+func testAccObjectsSyncConfig_pruneNoStray(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[2]q
+  prune      = true
+
+  allow_bucket_prune = true
+}
+`, rName, source)
+}
+
+func testAccObjectsSyncConfig_pruneBucketNotAllowed(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[2]q
+  prune      = true
+}
+`, rName, source)
+}
@@ -69,6 +69,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket",
 			Name:     "Bucket",
 			Tags:     &types.ServicePackageResourceTags{},
+			ARNImport: &types.ServicePackageResourceARNImport{
+				Service: "s3",
+			},
 		},
 		{
 			Factory:  ResourceBucketAccelerateConfiguration,
@@ -164,6 +167,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Object",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceObjectsSync,
+			TypeName: "aws_s3_objects_sync",
+			Name:     "Objects Sync",
+		},
 	}
 }
 
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateencryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+type kmsEncrypter struct {
+	conn  func(context.Context) kmsiface.KMSAPI
+	keyID string
+}
+
+// NewKMSEncrypter returns an Encrypter that uses the specified KMS key to encrypt values.
+// The KMS API client is obtained lazily as it is not available until the provider is configured.
+func NewKMSEncrypter(conn func(context.Context) kmsiface.KMSAPI, keyID string) Encrypter {
+	return &kmsEncrypter{
+		conn:  conn,
+		keyID: keyID,
+	}
+}
+
+func (e *kmsEncrypter) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	output, err := e.conn(ctx).EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(e.keyID),
+		Plaintext: []byte(plaintext),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return kmsValuePrefix + base64.StdEncoding.EncodeToString(output.CiphertextBlob), nil
+}
+
+func (e *kmsEncrypter) Decrypt(ctx context.Context, ciphertext string) (string, error) {
+	v, ok := strings.CutPrefix(ciphertext, kmsValuePrefix)
+
+	if !ok {
+		return "", errors.New("value was not encrypted using KMS")
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	output, err := e.conn(ctx).DecryptWithContext(ctx, &kms.DecryptInput{
+		CiphertextBlob: blob,
+		KeyId:          aws.String(e.keyID),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output.Plaintext), nil
+}
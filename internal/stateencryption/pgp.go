@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateencryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vault/helper/pgpkeys"
+)
+
+const keybasePrefix = "keybase:"
+
+type pgpEncrypter struct {
+	publicKey  string
+	privateKey string
+}
+
+// NewPGPEncrypter returns an Encrypter that uses the specified base64-encoded PGP public key,
+// or a Keybase username prefixed with "keybase:", to encrypt values.
+// The base64-encoded PGP private key is required to decrypt values.
+func NewPGPEncrypter(publicKey, privateKey string) (Encrypter, error) {
+	if strings.HasPrefix(publicKey, keybasePrefix) {
+		publicKeys, err := pgpkeys.FetchKeybasePubkeys([]string{publicKey})
+
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Public Key (%s): %w", publicKey, err)
+		}
+
+		publicKey = publicKeys[publicKey]
+	}
+
+	if _, err := pgpkeys.GetEntities([]string{publicKey}); err != nil {
+		return nil, err
+	}
+
+	return &pgpEncrypter{
+		publicKey:  publicKey,
+		privateKey: privateKey,
+	}, nil
+}
+
+func (e *pgpEncrypter) Encrypt(_ context.Context, plaintext string) (string, error) {
+	_, encrypted, err := pgpkeys.EncryptShares([][]byte{[]byte(plaintext)}, []string{e.publicKey})
+
+	if err != nil {
+		return "", err
+	}
+
+	return pgpValuePrefix + base64.StdEncoding.EncodeToString(encrypted[0]), nil
+}
+
+func (e *pgpEncrypter) Decrypt(_ context.Context, ciphertext string) (string, error) {
+	v, ok := strings.CutPrefix(ciphertext, pgpValuePrefix)
+
+	if !ok {
+		return "", errors.New("value was not encrypted using PGP")
+	}
+
+	if e.privateKey == "" {
+		return "", errors.New("PGP private key is required to decrypt value")
+	}
+
+	plaintext, err := pgpkeys.DecryptBytes(v, e.privateKey)
+
+	if err != nil {
+		return "", err
+	}
+
+	return plaintext.String(), nil
+}
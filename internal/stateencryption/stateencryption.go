@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package stateencryption implements encryption of sensitive resource attribute values stored in Terraform state.
+package stateencryption
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+)
+
+const (
+	// Encrypted values are prefixed so that they can be recognized when read from state.
+	encryptedValuePrefix = "tfenc:"
+	kmsValuePrefix       = encryptedValuePrefix + "kms:"
+	pgpValuePrefix       = encryptedValuePrefix + "pgp:"
+)
+
+// Encrypter encrypts and decrypts attribute values.
+type Encrypter interface {
+	Encrypt(context.Context, string) (string, error)
+	Decrypt(context.Context, string) (string, error)
+}
+
+// Config represents the provider-level state encryption configuration.
+// Exactly one of KMSKeyID or PGPKey must be set.
+type Config struct {
+	KMSKeyID      string
+	PGPKey        string
+	PGPPrivateKey string
+}
+
+// Validate checks that the configuration is consistent.
+func (c *Config) Validate() error {
+	if c.KMSKeyID == "" && c.PGPKey == "" {
+		return errors.New("one of `kms_key_id` or `pgp_key` must be specified")
+	}
+
+	if c.KMSKeyID != "" && (c.PGPKey != "" || c.PGPPrivateKey != "") {
+		return errors.New("`kms_key_id` conflicts with `pgp_key` and `pgp_private_key`")
+	}
+
+	return nil
+}
+
+// IsEncrypted returns whether or not the specified value was produced by an Encrypter.
+func IsEncrypted(v string) bool {
+	return strings.HasPrefix(v, encryptedValuePrefix)
+}
+
+// NewCachingEncrypter returns an Encrypter that remembers the plaintext of values encrypted or decrypted by the specified Encrypter.
+// This allows plan-time comparisons of encrypted values with configuration to avoid repeated decryption.
+func NewCachingEncrypter(e Encrypter) Encrypter {
+	return &cachingEncrypter{
+		encrypter:  e,
+		plaintexts: make(map[string]string),
+	}
+}
+
+type cachingEncrypter struct {
+	encrypter  Encrypter
+	mutex      sync.Mutex
+	plaintexts map[string]string // Keyed by encrypted value.
+}
+
+func (e *cachingEncrypter) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	ciphertext, err := e.encrypter.Encrypt(ctx, plaintext)
+
+	if err != nil {
+		return "", err
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.plaintexts[ciphertext] = plaintext
+
+	return ciphertext, nil
+}
+
+func (e *cachingEncrypter) Decrypt(ctx context.Context, ciphertext string) (string, error) {
+	e.mutex.Lock()
+	plaintext, ok := e.plaintexts[ciphertext]
+	e.mutex.Unlock()
+
+	if ok {
+		return plaintext, nil
+	}
+
+	plaintext, err := e.encrypter.Decrypt(ctx, ciphertext)
+
+	if err != nil {
+		return "", err
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.plaintexts[ciphertext] = plaintext
+
+	return plaintext, nil
+}
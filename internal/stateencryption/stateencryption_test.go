@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateencryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+func testPGPKeys(t *testing.T) (string, string) {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatalf("generating PGP key: %s", err)
+	}
+
+	var publicKey, privateKey bytes.Buffer
+	if err := entity.Serialize(&publicKey); err != nil {
+		t.Fatalf("serializing PGP public key: %s", err)
+	}
+	if err := entity.SerializePrivate(&privateKey, nil); err != nil {
+		t.Fatalf("serializing PGP private key: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(publicKey.Bytes()), base64.StdEncoding.EncodeToString(privateKey.Bytes())
+}
+
+// mockKMS "encrypts" by reversing the plaintext bytes.
+type mockKMS struct {
+	kmsiface.KMSAPI
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i, v := range b {
+		r[len(b)-1-i] = v
+	}
+	return r
+}
+
+func (mockKMS) EncryptWithContext(_ context.Context, input *kms.EncryptInput, _ ...request.Option) (*kms.EncryptOutput, error) {
+	return &kms.EncryptOutput{CiphertextBlob: reverse(input.Plaintext), KeyId: input.KeyId}, nil
+}
+
+func (mockKMS) DecryptWithContext(_ context.Context, input *kms.DecryptInput, _ ...request.Option) (*kms.DecryptOutput, error) {
+	return &kms.DecryptOutput{Plaintext: reverse(input.CiphertextBlob), KeyId: input.KeyId}, nil
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Name:        "empty",
+			ExpectError: true,
+		},
+		{
+			Name:   "KMS",
+			Config: Config{KMSKeyID: "alias/test"},
+		},
+		{
+			Name:   "PGP",
+			Config: Config{PGPKey: "keybase:test", PGPPrivateKey: "private"},
+		},
+		{
+			Name:        "KMS and PGP",
+			Config:      Config{KMSKeyID: "alias/test", PGPKey: "keybase:test"},
+			ExpectError: true,
+		},
+		{
+			Name:        "PGP private key only",
+			Config:      Config{PGPPrivateKey: "private"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Config.Validate()
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("Validate() error = %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
+func TestPGPEncrypter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	publicKey, privateKey := testPGPKeys(t)
+
+	e, err := NewPGPEncrypter(publicKey, privateKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	plaintext := "s3cr3t"
+	ciphertext, err := e.Encrypt(ctx, plaintext)
+	if err != nil {
+		t.Fatalf("encrypting: %s", err)
+	}
+
+	if !IsEncrypted(ciphertext) {
+		t.Errorf("expected %q to be recognized as encrypted", ciphertext)
+	}
+
+	got, err := e.Decrypt(ctx, ciphertext)
+	if err != nil {
+		t.Fatalf("decrypting: %s", err)
+	}
+
+	if got != plaintext {
+		t.Errorf("got %q, want %q", got, plaintext)
+	}
+
+	e, err = NewPGPEncrypter(publicKey, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := e.Decrypt(ctx, ciphertext); err == nil {
+		t.Error("expected error decrypting without private key")
+	}
+
+	if _, err := NewPGPEncrypter("invalid", ""); err == nil {
+		t.Error("expected error for invalid public key")
+	}
+}
+
+func TestKMSEncrypter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	e := NewKMSEncrypter(func(context.Context) kmsiface.KMSAPI { return mockKMS{} }, "alias/test")
+
+	plaintext := "s3cr3t"
+	ciphertext, err := e.Encrypt(ctx, plaintext)
+	if err != nil {
+		t.Fatalf("encrypting: %s", err)
+	}
+
+	if !IsEncrypted(ciphertext) {
+		t.Errorf("expected %q to be recognized as encrypted", ciphertext)
+	}
+
+	got, err := e.Decrypt(ctx, ciphertext)
+	if err != nil {
+		t.Fatalf("decrypting: %s", err)
+	}
+
+	if got != plaintext {
+		t.Errorf("got %q, want %q", got, plaintext)
+	}
+
+	publicKey, _ := testPGPKeys(t)
+	pgp, err := NewPGPEncrypter(publicKey, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ciphertext, err = pgp.Encrypt(ctx, plaintext)
+	if err != nil {
+		t.Fatalf("encrypting: %s", err)
+	}
+
+	if _, err := e.Decrypt(ctx, ciphertext); err == nil {
+		t.Error("expected error decrypting PGP-encrypted value")
+	}
+}
+
+func TestIsEncrypted(t *testing.T) {
+	t.Parallel()
+
+	for v, want := range map[string]bool{
+		"":                  false,
+		"password":          false,
+		"tfenc:kms:AAAA":    true,
+		"tfenc:pgp:AAAA":    true,
+		"prefix:tfenc:kms:": false,
+	} {
+		if got := IsEncrypted(v); got != want {
+			t.Errorf("IsEncrypted(%q) = %t, want %t", v, got, want)
+		}
+	}
+}
+
+// countingKMS counts the number of Decrypt calls.
+type countingKMS struct {
+	mockKMS
+	decrypts int
+}
+
+func (m *countingKMS) DecryptWithContext(ctx context.Context, input *kms.DecryptInput, opts ...request.Option) (*kms.DecryptOutput, error) {
+	m.decrypts++
+	return m.mockKMS.DecryptWithContext(ctx, input, opts...)
+}
+
+func TestCachingEncrypter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &countingKMS{}
+	e := NewCachingEncrypter(NewKMSEncrypter(func(context.Context) kmsiface.KMSAPI { return conn }, "alias/test"))
+
+	ciphertext, err := e.Encrypt(ctx, "s3cr3t")
+
+	if err != nil {
+		t.Fatalf("encrypting: %s", err)
+	}
+
+	// The plaintext of the value just encrypted is cached.
+	if plaintext, err := e.Decrypt(ctx, ciphertext); err != nil {
+		t.Fatalf("decrypting: %s", err)
+	} else if got, want := plaintext, "s3cr3t"; got != want {
+		t.Errorf("Decrypt = %q, want %q", got, want)
+	}
+
+	if got, want := conn.decrypts, 0; got != want {
+		t.Errorf("Decrypt calls = %d, want %d", got, want)
+	}
+
+	// A value encrypted elsewhere is decrypted once.
+	other := kmsValuePrefix + base64.StdEncoding.EncodeToString(reverse([]byte("other")))
+
+	for i := 0; i < 2; i++ {
+		if plaintext, err := e.Decrypt(ctx, other); err != nil {
+			t.Fatalf("decrypting: %s", err)
+		} else if got, want := plaintext, "other"; got != want {
+			t.Errorf("Decrypt = %q, want %q", got, want)
+		}
+	}
+
+	if got, want := conn.decrypts, 1; got != want {
+		t.Errorf("Decrypt calls = %d, want %d", got, want)
+	}
+}
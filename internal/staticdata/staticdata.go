@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package staticdata is a partition-aware registry of static AWS data such as
+// Route 53 hosted zone IDs, service account IDs and service principals.
+//
+// The registry is generated from staticdata.csv. Each entry applies either to a
+// single AWS Region or, when no Region is specified, to a whole partition.
+package staticdata
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// Kind is the kind of static data.
+type Kind string
+
+const (
+	KindAccountID        Kind = "account_id"
+	KindHostedZoneID     Kind = "hosted_zone_id"
+	KindServicePrincipal Kind = "service_principal"
+)
+
+func (Kind) Values() []Kind {
+	return []Kind{
+		KindAccountID,
+		KindHostedZoneID,
+		KindServicePrincipal,
+	}
+}
+
+// Services with static data.
+const (
+	// See https://docs.aws.amazon.com/general/latest/gr/elb.html#elb_region.
+	ServiceALB = "alb"
+	// See http://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/billing-getting-started.html#step-2.
+	ServiceBilling = "billing"
+	// See http://docs.aws.amazon.com/awscloudtrail/latest/userguide/cloudtrail-supported-regions.html,
+	// https://docs.aws.amazon.com/govcloud-us/latest/ug-east/verifying-cloudtrail.html and
+	// https://docs.aws.amazon.com/govcloud-us/latest/ug-west/verifying-cloudtrail.html.
+	ServiceCloudTrail = "cloudtrail"
+	// See https://docs.aws.amazon.com/Route53/latest/APIReference/API_AliasTarget.html#Route53-Type-AliasTarget-HostedZoneId and
+	// https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html.
+	ServiceCloudFront = "cloudfront"
+	// See https://docs.aws.amazon.com/general/latest/gr/elasticbeanstalk.html.
+	ServiceElasticBeanstalk = "elasticbeanstalk"
+	// See https://docs.aws.amazon.com/general/latest/gr/elb.html#elb_region and
+	// http://docs.aws.amazon.com/elasticloadbalancing/latest/classic/enable-access-logs.html#attach-bucket-policy.
+	ServiceELB = "elb"
+	// See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region.
+	ServiceGlobalAccelerator = "globalaccelerator"
+	// See https://docs.aws.amazon.com/general/latest/gr/elb.html#elb_region.
+	ServiceNLB = "nlb"
+	// See http://docs.aws.amazon.com/redshift/latest/mgmt/db-auditing.html#db-auditing-bucket-permissions,
+	// https://docs.aws.amazon.com/govcloud-us/latest/UserGuide/govcloud-redshift.html and
+	// https://docs.amazonaws.cn/en_us/redshift/latest/mgmt/db-auditing.html#db-auditing-bucket-permissions.
+	ServiceRedshift = "redshift"
+	// See https://docs.aws.amazon.com/general/latest/gr/s3.html#s3_website_region_endpoints.
+	ServiceS3Website = "s3-website"
+)
+
+// unsupportedPartitions are the AWS partitions for which static data of a kind is known not to be published.
+// Lookups in these partitions fail rather than returning another partition's value.
+var unsupportedPartitions = map[Kind][]string{
+	KindAccountID:    {endpoints.AwsIsoPartitionID, endpoints.AwsIsoBPartitionID},
+	KindHostedZoneID: {endpoints.AwsIsoPartitionID, endpoints.AwsIsoBPartitionID},
+}
+
+// ErrPartitionNotSupported is returned when static data of a kind is not available in an AWS partition.
+var ErrPartitionNotSupported = errors.New("not supported in AWS partition")
+
+// PartitionForRegion returns the ID of the partition containing the specified AWS Region.
+// Unknown Regions are assumed to be in the standard partition.
+func PartitionForRegion(region string) string {
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return p.ID()
+	}
+
+	return endpoints.AwsPartitionID
+}
+
+// Lookup returns the static data value of the specified kind for a service in an AWS Region.
+// If no Region-specific value is registered, the value registered for the Region's partition is returned.
+func Lookup(kind Kind, service, region string) (string, error) {
+	if v, ok := registry[kind][service][region]; ok {
+		return v, nil
+	}
+
+	partition := PartitionForRegion(region)
+
+	if v, ok := registry[kind][service][partition]; ok {
+		return v, nil
+	}
+
+	if isPartitionUnsupported(kind, partition) {
+		return "", fmt.Errorf("%s for %s in AWS Region (%s): %w (%s)", kind, service, region, ErrPartitionNotSupported, partition)
+	}
+
+	return "", fmt.Errorf("%s not found for %s in AWS Region (%s)", kind, service, region)
+}
+
+// LookupForPartition returns the partition-wide static data value of the specified kind for a service.
+func LookupForPartition(kind Kind, service, partition string) (string, error) {
+	if v, ok := registry[kind][service][partition]; ok {
+		return v, nil
+	}
+
+	if isPartitionUnsupported(kind, partition) {
+		return "", fmt.Errorf("%s for %s: %w (%s)", kind, service, ErrPartitionNotSupported, partition)
+	}
+
+	return "", fmt.Errorf("%s not found for %s in AWS partition (%s)", kind, service, partition)
+}
+
+func isPartitionUnsupported(kind Kind, partition string) bool {
+	for _, v := range unsupportedPartitions[kind] {
+		if v == partition {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccountID returns the AWS account ID used by a service in an AWS Region,
+// e.g. for delivery of Elastic Load Balancing access logs.
+func AccountID(service, region string) (string, error) {
+	return Lookup(KindAccountID, service, region)
+}
+
+// HostedZoneID returns the Route 53 hosted zone ID for a service's endpoints in an AWS Region.
+func HostedZoneID(service, region string) (string, error) {
+	return Lookup(KindHostedZoneID, service, region)
+}
+
+// ServicePrincipal returns the IAM service principal for a service in an AWS partition.
+// Services without a partition-specific service principal use "<service>.amazonaws.com".
+func ServicePrincipal(service, partition string) string {
+	if v, err := LookupForPartition(KindServicePrincipal, service, partition); err == nil {
+		return v
+	}
+
+	return service + ".amazonaws.com"
+}
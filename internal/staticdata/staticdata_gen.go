@@ -0,0 +1,297 @@
+// Code generated by internal/generate/staticdata/main.go; DO NOT EDIT.
+
+package staticdata
+
+// registry maps kind -> service -> AWS Region or partition -> value.
+var registry = map[Kind]map[string]map[string]string{
+	"account_id": {
+		"billing": {
+			"aws":        "386209384616",
+			"aws-cn":     "386209384616",
+			"aws-us-gov": "386209384616",
+		},
+		"cloudtrail": {
+			"af-south-1":     "525921808201",
+			"ap-east-1":      "119688915426",
+			"ap-northeast-1": "216624486486",
+			"ap-northeast-2": "492519147666",
+			"ap-northeast-3": "765225791966",
+			"ap-south-1":     "977081816279",
+			"ap-south-2":     "582488909970",
+			"ap-southeast-1": "903692715234",
+			"ap-southeast-2": "284668455005",
+			"ap-southeast-3": "069019280451",
+			"ap-southeast-4": "187074758985",
+			"ca-central-1":   "819402241893",
+			"cn-north-1":     "193415116832",
+			"cn-northwest-1": "681348832753",
+			"eu-central-1":   "035351147821",
+			"eu-central-2":   "453052556044",
+			"eu-north-1":     "829690693026",
+			"eu-south-1":     "669305197877",
+			"eu-south-2":     "757211635381",
+			"eu-west-1":      "859597730677",
+			"eu-west-2":      "282025262664",
+			"eu-west-3":      "262312530599",
+			"il-central-1":   "683224464357",
+			"me-central-1":   "585772288577",
+			"me-south-1":     "034638983726",
+			"sa-east-1":      "814480443879",
+			"us-east-1":      "086441151436",
+			"us-east-2":      "475085895292",
+			"us-gov-east-1":  "608710470296",
+			"us-gov-west-1":  "608710470296",
+			"us-west-1":      "388731089494",
+			"us-west-2":      "113285607260",
+		},
+		"elb": {
+			"af-south-1":     "098369216593",
+			"ap-east-1":      "754344448648",
+			"ap-northeast-1": "582318560864",
+			"ap-northeast-2": "600734575887",
+			"ap-northeast-3": "383597477331",
+			"ap-south-1":     "718504428378",
+			"ap-southeast-1": "114774131450",
+			"ap-southeast-2": "783225319266",
+			"ap-southeast-3": "589379963580",
+			"ca-central-1":   "985666609251",
+			"cn-north-1":     "638102146993",
+			"cn-northwest-1": "037604701340",
+			"eu-central-1":   "054676820928",
+			"eu-north-1":     "897822967062",
+			"eu-south-1":     "635631232127",
+			"eu-west-1":      "156460612806",
+			"eu-west-2":      "652711504416",
+			"eu-west-3":      "009996457667",
+			"me-south-1":     "076674570225",
+			"sa-east-1":      "507241528517",
+			"us-east-1":      "127311923021",
+			"us-east-2":      "033677994240",
+			"us-gov-east-1":  "190560391635",
+			"us-gov-west-1":  "048591011584",
+			"us-west-1":      "027434742980",
+			"us-west-2":      "797873946194",
+		},
+		"redshift": {
+			"af-south-1":     "365689465814",
+			"ap-east-1":      "313564881002",
+			"ap-northeast-1": "404641285394",
+			"ap-northeast-2": "760740231472",
+			"ap-northeast-3": "090321488786",
+			"ap-south-1":     "865932855811",
+			"ap-southeast-1": "361669875840",
+			"ap-southeast-2": "762762565011",
+			"ca-central-1":   "907379612154",
+			"cn-north-1":     "111890595117",
+			"cn-northwest-1": "660998842044",
+			"eu-central-1":   "053454850223",
+			"eu-north-1":     "729911121831",
+			"eu-south-1":     "945612479654",
+			"eu-west-1":      "210876761215",
+			"eu-west-2":      "307160386991",
+			"eu-west-3":      "915173422425",
+			"me-south-1":     "013126148197",
+			"sa-east-1":      "075028567923",
+			"us-east-1":      "193672423079",
+			"us-east-2":      "391106570357",
+			"us-gov-east-1":  "665727464434",
+			"us-gov-west-1":  "665727464434",
+			"us-west-1":      "262260360010",
+			"us-west-2":      "902366379725",
+		},
+	},
+	"hosted_zone_id": {
+		"alb": {
+			"af-south-1":     "Z268VQBMOI5EKX",
+			"ap-east-1":      "Z3DQVH9N71FHZ0",
+			"ap-northeast-1": "Z14GRHDCWA56QT",
+			"ap-northeast-2": "ZWKZPGTI48KDX",
+			"ap-northeast-3": "Z5LXEXXYW11ES",
+			"ap-south-1":     "ZP97RAFLXTNZK",
+			"ap-south-2":     "Z0173938T07WNTVAEPZN",
+			"ap-southeast-1": "Z1LMS91P8CMLE5",
+			"ap-southeast-2": "Z1GM3OXH4ZPM65",
+			"ap-southeast-3": "Z08888821HLRG5A9ZRTER",
+			"ap-southeast-4": "Z09517862IB2WZLPXG76F",
+			"ca-central-1":   "ZQSVJUPU6J1EY",
+			"cn-north-1":     "Z1GDH35T77C1KE",
+			"cn-northwest-1": "ZM7IZAIOVVDZF",
+			"eu-central-1":   "Z215JYRZR1TBD5",
+			"eu-central-2":   "Z06391101F2ZOEP8P5EB3",
+			"eu-north-1":     "Z23TAZ6LKFMNIO",
+			"eu-south-1":     "Z3ULH7SSC9OV64",
+			"eu-south-2":     "Z0956581394HF5D5LXGAP",
+			"eu-west-1":      "Z32O12XQLNTSW2",
+			"eu-west-2":      "ZHURV8PSTC4K8",
+			"eu-west-3":      "Z3Q77PNBQS71R4",
+			"il-central-1":   "Z09170902867EHPV2DABU",
+			"me-central-1":   "Z08230872XQRWHG2XF6I",
+			"me-south-1":     "ZS929ML54UICD",
+			"sa-east-1":      "Z2P70J7HTTTPLU",
+			"us-east-1":      "Z35SXDOTRQ7X7K",
+			"us-east-2":      "Z3AADJGX6KTTL2",
+			"us-gov-east-1":  "Z166TLBEWOO7G0",
+			"us-gov-west-1":  "Z33AYJ8TM3BH4J",
+			"us-west-1":      "Z368ELLRRE2KJ0",
+			"us-west-2":      "Z1H1FL5HABSF5",
+		},
+		"cloudfront": {
+			"aws":    "Z2FDTNDATAQYW2",
+			"aws-cn": "Z3RFFRIM2A3IF5",
+		},
+		"elasticbeanstalk": {
+			"af-south-1":     "Z1EI3BVKMKK4AM",
+			"ap-east-1":      "ZPWYUBWRU171A",
+			"ap-northeast-1": "Z1R25G3KIG2GBW",
+			"ap-northeast-2": "Z3JE5OI70TWKCP",
+			"ap-northeast-3": "ZNE5GEY1TIAGY",
+			"ap-south-1":     "Z18NTBI3Y7N9TZ",
+			"ap-southeast-1": "Z16FZ9L249IFLT",
+			"ap-southeast-2": "Z2PCDNR3VC2G1N",
+			"ap-southeast-3": "Z05913172VM7EAZB40TA8",
+			"ca-central-1":   "ZJFCZL7SSZB5I",
+			"eu-central-1":   "Z1FRNW7UH4DEZJ",
+			"eu-north-1":     "Z23GO28BZ5AETM",
+			"eu-south-1":     "Z10VDYYOA2JFKM",
+			"eu-west-1":      "Z2NYPWQ7DFZAZH",
+			"eu-west-2":      "Z1GKAAAUGATPF1",
+			"eu-west-3":      "Z5WN6GAYWG5OB",
+			"me-south-1":     "Z2BBTEKR2I36N2",
+			"sa-east-1":      "Z10X7K2B4QSOFV",
+			"us-east-1":      "Z117KPS5GTRQ2G",
+			"us-east-2":      "Z14LCN19Q5QHIC",
+			"us-gov-east-1":  "Z35TSARG0EJ4VU",
+			"us-gov-west-1":  "Z4KAURWC4UUUG",
+			"us-west-1":      "Z1LQECGX5PH1X",
+			"us-west-2":      "Z38NKT9BP95V3O",
+		},
+		"elb": {
+			"af-south-1":     "Z268VQBMOI5EKX",
+			"ap-east-1":      "Z3DQVH9N71FHZ0",
+			"ap-northeast-1": "Z14GRHDCWA56QT",
+			"ap-northeast-2": "ZWKZPGTI48KDX",
+			"ap-northeast-3": "Z5LXEXXYW11ES",
+			"ap-south-1":     "ZP97RAFLXTNZK",
+			"ap-south-2":     "Z0173938T07WNTVAEPZN",
+			"ap-southeast-1": "Z1LMS91P8CMLE5",
+			"ap-southeast-2": "Z1GM3OXH4ZPM65",
+			"ap-southeast-3": "Z08888821HLRG5A9ZRTER",
+			"ap-southeast-4": "Z09517862IB2WZLPXG76F",
+			"ca-central-1":   "ZQSVJUPU6J1EY",
+			"cn-north-1":     "Z1GDH35T77C1KE",
+			"cn-northwest-1": "ZM7IZAIOVVDZF",
+			"eu-central-1":   "Z215JYRZR1TBD5",
+			"eu-central-2":   "Z06391101F2ZOEP8P5EB3",
+			"eu-north-1":     "Z23TAZ6LKFMNIO",
+			"eu-south-1":     "Z3ULH7SSC9OV64",
+			"eu-south-2":     "Z0956581394HF5D5LXGAP",
+			"eu-west-1":      "Z32O12XQLNTSW2",
+			"eu-west-2":      "ZHURV8PSTC4K8",
+			"eu-west-3":      "Z3Q77PNBQS71R4",
+			"il-central-1":   "Z09170902867EHPV2DABU",
+			"me-central-1":   "Z08230872XQRWHG2XF6I",
+			"me-south-1":     "ZS929ML54UICD",
+			"sa-east-1":      "Z2P70J7HTTTPLU",
+			"us-east-1":      "Z35SXDOTRQ7X7K",
+			"us-east-2":      "Z3AADJGX6KTTL2",
+			"us-gov-east-1":  "Z166TLBEWOO7G0",
+			"us-gov-west-1":  "Z33AYJ8TM3BH4J",
+			"us-west-1":      "Z368ELLRRE2KJ0",
+			"us-west-2":      "Z1H1FL5HABSF5",
+		},
+		"globalaccelerator": {
+			"aws": "Z2BJ6XQ5FK7U4H",
+		},
+		"nlb": {
+			"af-south-1":     "Z203XCE67M25HM",
+			"ap-east-1":      "Z12Y7K3UBGUAD1",
+			"ap-northeast-1": "Z31USIVHYNEOWT",
+			"ap-northeast-2": "ZIBE1TIR4HY56",
+			"ap-northeast-3": "Z1GWIQ4HH19I5X",
+			"ap-south-1":     "ZVDDRBQ08TROA",
+			"ap-south-2":     "Z0711778386UTO08407HT",
+			"ap-southeast-1": "ZKVM4W9LS7TM",
+			"ap-southeast-2": "ZCT6FZBF4DROD",
+			"ap-southeast-3": "Z01971771FYVNCOVWJU1G",
+			"ap-southeast-4": "Z01156963G8MIIL7X90IV",
+			"ca-central-1":   "Z2EPGBW3API2WT",
+			"cn-north-1":     "Z3QFB96KMJ7ED6",
+			"cn-northwest-1": "ZQEIKTCZ8352D",
+			"eu-central-1":   "Z3F0SRJ5LGBH90",
+			"eu-central-2":   "Z02239872DOALSIDCX66S",
+			"eu-north-1":     "Z1UDT6IFJ4EJM",
+			"eu-south-1":     "Z23146JA1KNAFP",
+			"eu-south-2":     "Z1011216NVTVYADP1SSV",
+			"eu-west-1":      "Z2IFOLAFXWLO4F",
+			"eu-west-2":      "ZD4D7Y8KGAS4G",
+			"eu-west-3":      "Z1CMS0P5QUZ6D5",
+			"il-central-1":   "Z0313266YDI6ZRHTGQY4",
+			"me-central-1":   "Z00282643NTTLPANJJG2P",
+			"me-south-1":     "Z3QSRYVP46NYYV",
+			"sa-east-1":      "ZTK26PT1VY4CU",
+			"us-east-1":      "Z26RNL4JYFTOTI",
+			"us-east-2":      "ZLMOA37VPKANP",
+			"us-gov-east-1":  "Z1ZSMQQ6Q24QQ8",
+			"us-gov-west-1":  "ZMG1MZ2THAWF1",
+			"us-west-1":      "Z24FKFUX50B4VW",
+			"us-west-2":      "Z18D5FSROUN65G",
+		},
+		"s3-website": {
+			"af-south-1":     "Z83WF9RJE8B12",
+			"ap-east-1":      "ZNB98KWMFR0R6",
+			"ap-northeast-1": "Z2M4EHUR26P7ZW",
+			"ap-northeast-2": "Z3W03O7B5YMIYP",
+			"ap-northeast-3": "Z2YQB5RD63NC85",
+			"ap-south-1":     "Z11RGJOFQNVJUP",
+			"ap-south-2":     "Z02976202B4EZMXIPMXF7",
+			"ap-southeast-1": "Z3O0J2DXBE1FTB",
+			"ap-southeast-2": "Z1WCIGYICN2BYD",
+			"ap-southeast-3": "Z01846753K324LI26A3VV",
+			"ap-southeast-4": "Z0312387243XT5FE14WFO",
+			"ca-central-1":   "Z1QDHH18159H29",
+			"cn-north-1":     "Z5CN8UMXT92WN",
+			"cn-northwest-1": "Z282HJ1KT0DH03",
+			"eu-central-1":   "Z21DNDUVLTQW6Q",
+			"eu-central-2":   "Z030506016YDQGETNASS",
+			"eu-north-1":     "Z3BAZG2TWCNX0D",
+			"eu-south-1":     "Z30OZKI7KPW7MI",
+			"eu-south-2":     "Z0081959F7139GRJC19J",
+			"eu-west-1":      "Z1BKCTXD74EZPE",
+			"eu-west-2":      "Z3GKZC51ZF0DB4",
+			"eu-west-3":      "Z3R1K369G5AVDG",
+			"il-central-1":   "Z09640613K4A3MN55U7GU",
+			"me-central-1":   "Z06143092I8HRXZRUZROF",
+			"me-south-1":     "Z1MPMWCPA7YB62",
+			"sa-east-1":      "Z7KQH4QJS55SO",
+			"us-east-1":      "Z3AQBSTGFYJSTF",
+			"us-east-2":      "Z2O1EMRO9K5GLX",
+			"us-gov-east-1":  "Z2NIFVYYW2VKV1",
+			"us-gov-west-1":  "Z31GFT0UA1I2HV",
+			"us-west-1":      "Z2F56UZL2M1ACD",
+			"us-west-2":      "Z3BJ6K6RIION7M",
+		},
+	},
+	"service_principal": {
+		"cloudtrail": {
+			"aws-iso": "cloudtrail.c2s.ic.gov",
+		},
+		"codedeploy": {
+			"aws-cn": "codedeploy.amazonaws.com.cn",
+		},
+		"dms": {
+			"aws-iso":   "dms.c2s.ic.gov",
+			"aws-iso-b": "dms.sc2s.sgov.gov",
+		},
+		"elasticmapreduce": {
+			"aws-cn": "elasticmapreduce.amazonaws.com.cn",
+		},
+		"logs": {
+			"aws-cn":    "logs.amazonaws.com.cn",
+			"aws-iso":   "logs.c2s.ic.gov",
+			"aws-iso-b": "logs.sc2s.sgov.gov",
+		},
+		"sns": {
+			"aws-iso": "sns.c2s.ic.gov",
+		},
+	},
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package staticdata
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"us-west-2":      "aws",
+		"cn-northwest-1": "aws-cn",
+		"us-gov-west-1":  "aws-us-gov",
+		"us-iso-east-1":  "aws-iso",
+		"us-isob-east-1": "aws-iso-b",
+		"xx-unknown-1":   "aws",
+	}
+
+	for region, expected := range testCases {
+		region, expected := region, expected
+		t.Run(region, func(t *testing.T) {
+			t.Parallel()
+
+			if got := PartitionForRegion(region); got != expected {
+				t.Errorf("PartitionForRegion(%q) = %q, want %q", region, got, expected)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		kind        Kind
+		service     string
+		region      string
+		expected    string
+		expectError bool
+		unsupported bool
+	}{
+		{
+			name:     "aws Region-specific",
+			kind:     KindHostedZoneID,
+			service:  ServiceS3Website,
+			region:   "us-east-1",
+			expected: "Z3AQBSTGFYJSTF",
+		},
+		{
+			name:     "aws partition-wide",
+			kind:     KindHostedZoneID,
+			service:  ServiceCloudFront,
+			region:   "eu-west-1",
+			expected: "Z2FDTNDATAQYW2",
+		},
+		{
+			name:     "aws-cn Region-specific",
+			kind:     KindAccountID,
+			service:  ServiceELB,
+			region:   "cn-north-1",
+			expected: "638102146993",
+		},
+		{
+			name:     "aws-cn partition-wide",
+			kind:     KindHostedZoneID,
+			service:  ServiceCloudFront,
+			region:   "cn-northwest-1",
+			expected: "Z3RFFRIM2A3IF5",
+		},
+		{
+			name:     "aws-us-gov Region-specific",
+			kind:     KindHostedZoneID,
+			service:  ServiceNLB,
+			region:   "us-gov-west-1",
+			expected: "ZMG1MZ2THAWF1",
+		},
+		{
+			name:        "aws-us-gov partition-wide not registered",
+			kind:        KindHostedZoneID,
+			service:     ServiceGlobalAccelerator,
+			region:      "us-gov-east-1",
+			expectError: true,
+		},
+		{
+			name:        "aws-iso not supported",
+			kind:        KindAccountID,
+			service:     ServiceELB,
+			region:      "us-iso-east-1",
+			expectError: true,
+			unsupported: true,
+		},
+		{
+			name:        "aws-iso-b not supported",
+			kind:        KindHostedZoneID,
+			service:     ServiceALB,
+			region:      "us-isob-east-1",
+			expectError: true,
+			unsupported: true,
+		},
+		{
+			name:        "unknown service",
+			kind:        KindHostedZoneID,
+			service:     "unknown",
+			region:      "us-east-1",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Lookup(testCase.kind, testCase.service, testCase.region)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("Lookup() error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := errors.Is(err, ErrPartitionNotSupported), testCase.unsupported; got != want {
+				t.Errorf("Lookup() error = %v, expected partition not supported: %t", err, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("Lookup() = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestLookupForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		kind        Kind
+		service     string
+		partition   string
+		expected    string
+		expectError bool
+		unsupported bool
+	}{
+		{
+			kind:      KindAccountID,
+			service:   ServiceBilling,
+			partition: "aws",
+			expected:  "386209384616",
+		},
+		{
+			kind:      KindAccountID,
+			service:   ServiceBilling,
+			partition: "aws-cn",
+			expected:  "386209384616",
+		},
+		{
+			kind:      KindAccountID,
+			service:   ServiceBilling,
+			partition: "aws-us-gov",
+			expected:  "386209384616",
+		},
+		{
+			kind:        KindAccountID,
+			service:     ServiceBilling,
+			partition:   "aws-iso",
+			expectError: true,
+			unsupported: true,
+		},
+		{
+			kind:        KindAccountID,
+			service:     ServiceBilling,
+			partition:   "aws-iso-b",
+			expectError: true,
+			unsupported: true,
+		},
+		{
+			kind:        KindHostedZoneID,
+			service:     ServiceCloudFront,
+			partition:   "aws-iso",
+			expectError: true,
+			unsupported: true,
+		},
+		{
+			kind:        KindHostedZoneID,
+			service:     ServiceCloudFront,
+			partition:   "aws-iso-b",
+			expectError: true,
+			unsupported: true,
+		},
+		{
+			kind:        KindHostedZoneID,
+			service:     ServiceCloudFront,
+			partition:   "aws-us-gov",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s/%s/%s", testCase.kind, testCase.service, testCase.partition), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := LookupForPartition(testCase.kind, testCase.service, testCase.partition)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("LookupForPartition() error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := errors.Is(err, ErrPartitionNotSupported), testCase.unsupported; got != want {
+				t.Errorf("LookupForPartition() error = %v, expected partition not supported: %t", err, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("LookupForPartition() = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestServicePrincipal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		service   string
+		partition string
+		expected  string
+	}{
+		{
+			service:   "logs",
+			partition: "aws",
+			expected:  "logs.amazonaws.com",
+		},
+		{
+			service:   "logs",
+			partition: "aws-cn",
+			expected:  "logs.amazonaws.com.cn",
+		},
+		{
+			service:   "ec2",
+			partition: "aws-cn",
+			expected:  "ec2.amazonaws.com",
+		},
+		{
+			service:   "logs",
+			partition: "aws-us-gov",
+			expected:  "logs.amazonaws.com",
+		},
+		{
+			service:   "sns",
+			partition: "aws-iso",
+			expected:  "sns.c2s.ic.gov",
+		},
+		{
+			service:   "logs",
+			partition: "aws-iso-b",
+			expected:  "logs.sc2s.sgov.gov",
+		},
+		{
+			service:   "sns",
+			partition: "aws-iso-b",
+			expected:  "sns.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.service+"/"+testCase.partition, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ServicePrincipal(testCase.service, testCase.partition); got != testCase.expected {
+				t.Errorf("ServicePrincipal(%q, %q) = %q, want %q", testCase.service, testCase.partition, got, testCase.expected)
+			}
+		})
+	}
+}
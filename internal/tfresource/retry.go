@@ -5,16 +5,14 @@ package tfresource
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math/rand"
 	"sync"
 	"time"
 
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -23,130 +21,77 @@ import (
 // If the error is not retryable, returns a bool value of `false` and either no error (success state) or an error (not necessarily the error passed as the argument).
 type Retryable func(error) (bool, error)
 
+// retryOptions match the minimum and maximum polling intervals of Retry's retry.StateChangeConf.
+var retryOptions = tfretry.Options{
+	BackoffMinDuration: 500 * time.Millisecond,
+	BackoffMaxDuration: 10 * time.Second,
+	BackoffMultiplier:  2,
+	DisableJitter:      true,
+	FinalAttempt:       true,
+}
+
+// retryWhen retries the function `f` while `predicate` indicates that its result is retryable.
+// `f` is retried until `timeout` expires, after which a final attempt is made and its result returned.
+func retryWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), predicate tfretry.PredicateFunc[T]) (T, error) {
+	return tfretry.Operation(func(context.Context) (T, error) {
+		return f()
+	}).If(predicate).WithOptions(retryOptions).Run(ctx, timeout)
+}
+
 // RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
 // `f` is retried until `timeout` expires.
 func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
-	var output interface{}
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		var again bool
-
-		output, err = f()
-		again, err = retryable(err)
-
-		if again {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
+	return retryWhen(ctx, timeout, f, func(_ interface{}, err error) (bool, error) {
+		return retryable(err)
 	})
+}
 
-	if TimedOut(err) {
-		output, err = f()
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+// retryWhenErr retries the function `f` when the error it returns satisfies `predicate`.
+func retryWhenErr(ctx context.Context, timeout time.Duration, f func() (interface{}, error), predicate tfretry.ErrorPredicate) (interface{}, error) {
+	return retryWhen(ctx, timeout, f, func(_ interface{}, err error) (bool, error) {
+		return err != nil && predicate(err), err
+	})
 }
 
 // RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
 func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeEquals(err, codes...) || tfawserr_sdkv2.ErrCodeEquals(err, codes...) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryWhenErr(ctx, timeout, f, tfretry.ErrCodeEquals(codes...))
 }
 
 // RetryWhenAWSErrMessageContains retries the specified function when it returns an AWS error containing the specified message.
 func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code, message string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrMessageContains(err, code, message) || tfawserr_sdkv2.ErrMessageContains(err, code, message) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryWhenErr(ctx, timeout, f, tfretry.ErrMessageContains(code, message))
 }
 
 func RetryWhenIsA[T error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryWhenErr(ctx, timeout, f, tfretry.IsA[T]())
 }
 
 func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (interface{}, error), needle string) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsAErrorMessageContains[T](err, needle) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryWhenErr(ctx, timeout, f, tfretry.IsAErrorMessageContains[T](needle))
 }
 
 // RetryUntilEqual retries the specified function until it returns a value equal to `t`.
 func RetryUntilEqual[T comparable](ctx context.Context, timeout time.Duration, t T, f func() (T, error)) (T, error) {
-	var output T
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-
-		output, err = f()
-
+	return retryWhen(ctx, timeout, f, func(output T, err error) (bool, error) {
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return false, err
 		}
 
 		if output != t {
-			return retry.RetryableError(fmt.Errorf("output = %v, want %v", output, t))
+			return true, fmt.Errorf("output = %v, want %v", output, t)
 		}
 
-		return nil
+		return false, nil
 	})
-
-	if TimedOut(err) {
-		output, err = f()
-
-		if err == nil && output != t {
-			err = fmt.Errorf("output = %v, want %v", output, t)
-		}
-	}
-
-	if err != nil {
-		var zero T
-		return zero, err
-	}
-
-	return output, nil
 }
 
 // RetryWhenHTTPStatusCodeEquals retries the specified function when it returns one of the specified HTTP status codes.
 func RetryWhenHTTPStatusCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), statusCodes ...int) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr_sdkv2.ErrHTTPStatusCodeEquals(err, statusCodes...) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryWhenErr(ctx, timeout, f, tfretry.ErrHTTPStatusCodeEquals(statusCodes...))
 }
 
-var ErrFoundResource = errors.New(`found resource`)
+var ErrFoundResource = tfretry.ErrFoundResource
 
 // RetryUntilNotFound retries the specified function until it returns a retry.NotFoundError.
 func RetryUntilNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
@@ -165,23 +110,13 @@ func RetryUntilNotFound(ctx context.Context, timeout time.Duration, f func() (in
 
 // RetryWhenNotFound retries the specified function when it returns a retry.NotFoundError.
 func RetryWhenNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if NotFound(err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return retryWhenErr(ctx, timeout, f, tfretry.NotFound())
 }
 
 // RetryWhenNewResourceNotFound retries the specified function when it returns a retry.NotFoundError and `isNewResource` is true.
 func RetryWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if isNewResource && NotFound(err) {
-			return true, err
-		}
-
-		return false, err
+	return retryWhenErr(ctx, timeout, f, func(err error) bool {
+		return isNewResource && NotFound(err)
 	})
 }
 
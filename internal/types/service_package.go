@@ -5,7 +5,10 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +20,63 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceARNImport represents resource-level ARN-based import information.
+// It describes how an ARN's resource component maps to the resource's ID.
+type ServicePackageResourceARNImport struct {
+	Service        string // The ARN service component, e.g. "iam"
+	ResourcePrefix string // The required prefix of the ARN resource component, e.g. "role/". The prefix is removed from the ID
+	ResourceSuffix string // An optional suffix of the ARN resource component, e.g. ":*". The suffix is removed from the ID
+	LastSegment    bool   // Whether only the last slash-separated segment of the remaining ARN resource component is the ID, e.g. removing an IAM path
+}
+
+// ResourceID returns the resource ID corresponding to the specified ARN.
+// The ARN must be in the specified partition and account, and for regional resources, in the specified Region.
+// An empty account ID, e.g. when the provider is configured to skip requesting it, is not checked.
+func (v *ServicePackageResourceARNImport) ResourceID(s, partition, region, accountID string) (string, error) {
+	resourceARN, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	if resourceARN.Service != v.Service {
+		return "", fmt.Errorf("ARN (%s) service (%s) does not match expected (%s)", s, resourceARN.Service, v.Service)
+	}
+
+	if resourceARN.Partition != partition {
+		return "", fmt.Errorf("ARN (%s) partition (%s) does not match the provider's (%s)", s, resourceARN.Partition, partition)
+	}
+
+	// Global resources, e.g. IAM roles, have no Region in their ARNs.
+	if resourceARN.Region != "" && resourceARN.Region != region {
+		return "", fmt.Errorf("ARN (%s) Region (%s) does not match the provider's (%s)", s, resourceARN.Region, region)
+	}
+
+	if resourceARN.AccountID != "" && accountID != "" && resourceARN.AccountID != accountID {
+		return "", fmt.Errorf("ARN (%s) account (%s) does not match the provider's (%s)", s, resourceARN.AccountID, accountID)
+	}
+
+	id, ok := strings.CutPrefix(resourceARN.Resource, v.ResourcePrefix)
+	if !ok {
+		return "", fmt.Errorf("ARN (%s) resource (%s) does not begin with expected prefix (%s)", s, resourceARN.Resource, v.ResourcePrefix)
+	}
+
+	if v.ResourceSuffix != "" {
+		id = strings.TrimSuffix(id, v.ResourceSuffix)
+	}
+
+	if v.LastSegment {
+		if i := strings.LastIndex(id, "/"); i >= 0 {
+			id = id[i+1:]
+		}
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("ARN (%s) resource (%s) contains no resource ID", s, resourceARN.Resource)
+	}
+
+	return id, nil
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -28,9 +88,10 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory   func(context.Context) (resource.ResourceWithConfigure, error)
+	Name      string
+	Tags      *ServicePackageResourceTags
+	ARNImport *ServicePackageResourceARNImport
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -45,8 +106,9 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory   func() *schema.Resource
+	TypeName  string
+	Name      string
+	Tags      *ServicePackageResourceTags
+	ARNImport *ServicePackageResourceARNImport
 }
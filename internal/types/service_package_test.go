@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+)
+
+func TestServicePackageResourceARNImportResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		ARNImport     ServicePackageResourceARNImport
+		ARN           string
+		ExpectedID    string
+		ExpectedError bool
+	}{
+		{
+			TestName:      "invalid ARN",
+			ARNImport:     ServicePackageResourceARNImport{Service: "s3"},
+			ARN:           "my-bucket",
+			ExpectedError: true,
+		},
+		{
+			TestName:   "no resource prefix",
+			ARNImport:  ServicePackageResourceARNImport{Service: "s3"},
+			ARN:        "arn:aws:s3:::my-bucket",
+			ExpectedID: "my-bucket",
+		},
+		{
+			TestName:      "service mismatch",
+			ARNImport:     ServicePackageResourceARNImport{Service: "s3"},
+			ARN:           "arn:aws:sqs:us-west-2:123456789012:my-queue",
+			ExpectedError: true,
+		},
+		{
+			TestName:   "resource prefix",
+			ARNImport:  ServicePackageResourceARNImport{Service: "dynamodb", ResourcePrefix: "table/"},
+			ARN:        "arn:aws:dynamodb:us-west-2:123456789012:table/my-table",
+			ExpectedID: "my-table",
+		},
+		{
+			TestName:      "resource prefix mismatch",
+			ARNImport:     ServicePackageResourceARNImport{Service: "ec2", ResourcePrefix: "vpc/"},
+			ARN:           "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty resource ID",
+			ARNImport:     ServicePackageResourceARNImport{Service: "rds", ResourcePrefix: "cluster:"},
+			ARN:           "arn:aws:rds:us-west-2:123456789012:cluster:",
+			ExpectedError: true,
+		},
+		{
+			TestName:   "nested resource ID",
+			ARNImport:  ServicePackageResourceARNImport{Service: "ecr", ResourcePrefix: "repository/"},
+			ARN:        "arn:aws:ecr:us-west-2:123456789012:repository/team/my-repo",
+			ExpectedID: "team/my-repo",
+		},
+		{
+			TestName:   "last segment",
+			ARNImport:  ServicePackageResourceARNImport{Service: "iam", ResourcePrefix: "role/", LastSegment: true},
+			ARN:        "arn:aws:iam::123456789012:role/service-role/my-role",
+			ExpectedID: "my-role",
+		},
+		{
+			TestName:   "last segment no path",
+			ARNImport:  ServicePackageResourceARNImport{Service: "iam", ResourcePrefix: "role/", LastSegment: true},
+			ARN:        "arn:aws:iam::123456789012:role/my-role",
+			ExpectedID: "my-role",
+		},
+		{
+			TestName:   "resource suffix",
+			ARNImport:  ServicePackageResourceARNImport{Service: "logs", ResourcePrefix: "log-group:", ResourceSuffix: ":*"},
+			ARN:        "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/my-function:*",
+			ExpectedID: "/aws/lambda/my-function",
+		},
+		{
+			TestName:   "resource suffix absent",
+			ARNImport:  ServicePackageResourceARNImport{Service: "logs", ResourcePrefix: "log-group:", ResourceSuffix: ":*"},
+			ARN:        "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/my-function",
+			ExpectedID: "/aws/lambda/my-function",
+		},
+		{
+			TestName:      "partition mismatch",
+			ARNImport:     ServicePackageResourceARNImport{Service: "dynamodb", ResourcePrefix: "table/"},
+			ARN:           "arn:aws-us-gov:dynamodb:us-west-2:123456789012:table/my-table",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "Region mismatch",
+			ARNImport:     ServicePackageResourceARNImport{Service: "dynamodb", ResourcePrefix: "table/"},
+			ARN:           "arn:aws:dynamodb:us-east-1:123456789012:table/my-table",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "account mismatch",
+			ARNImport:     ServicePackageResourceARNImport{Service: "dynamodb", ResourcePrefix: "table/"},
+			ARN:           "arn:aws:dynamodb:us-west-2:210987654321:table/my-table",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "global resource account mismatch",
+			ARNImport:     ServicePackageResourceARNImport{Service: "iam", ResourcePrefix: "role/", LastSegment: true},
+			ARN:           "arn:aws:iam::210987654321:role/my-role",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.ARNImport.ResourceID(testCase.ARN, "aws", "us-west-2", "123456789012")
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}